@@ -2,48 +2,351 @@ package actuator
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net"
 	"net/http"
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/bencoronard/demo-go-common-libs/dto"
+	xjwt "github.com/bencoronard/demo-go-common-libs/jwt"
+	"github.com/labstack/echo/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.uber.org/fx"
 )
 
 type actuator struct {
-	ready          atomic.Bool
-	healthCheckers []HealthChecker
-	config         HealthCheckConfig
+	tracked            atomic.Bool
+	booted             atomic.Bool
+	started            atomic.Bool
+	ready              atomic.Bool
+	draining           atomic.Bool
+	alive              atomic.Bool
+	heartbeat          atomic.Int64
+	override           atomic.Pointer[Override]
+	config             HealthCheckConfig
+	clock              Clock
+	mu                 sync.RWMutex
+	components         []*component
+	livenessComponents []*component
+	livenessFailures   int
+	groups             map[string][]*component
+	refresh            chan struct{}
+	wake               chan struct{}
+	drainOnce          sync.Once
+	drained            chan struct{}
+	metrics            *metrics
+	subMu              sync.Mutex
+	subscribers        map[chan HealthEvent]struct{}
 }
 
 func (a *actuator) Liveness() bool {
-	return true
+	return a.alive.Load()
 }
 
 func (a *actuator) Readiness() bool {
-	return a.ready.Load()
+	if a.draining.Load() {
+		return false
+	}
+	if o, ok := a.Override(); ok {
+		return o.Mode == OverrideInService
+	}
+	return a.started.Load() && a.ready.Load()
+}
+
+func (a *actuator) Startup() bool {
+	return a.started.Load()
+}
+
+type startupParams struct {
+	fx.In
+	Lifecycle fx.Lifecycle
+}
+
+func (a *actuator) TrackStartup(p startupParams) error {
+	a.tracked.Store(true)
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			a.Boot()
+			return nil
+		},
+	})
+
+	return nil
+}
+
+func (a *actuator) Boot() {
+	a.booted.Store(true)
+	select {
+	case a.refresh <- struct{}{}:
+	default:
+	}
+}
+
+func (a *actuator) Health() HealthReport {
+	components := a.snapshot(a.components)
+	liveness := a.snapshot(a.livenessComponents)
+
+	report := HealthReport{
+		Status:     aggregate(components),
+		Components: components,
+		Liveness:   liveness,
+	}
+	a.adjust(&report)
+
+	return report
+}
+
+// adjust keeps the reported status consistent with Readiness while the
+// application is still starting or draining, or while an override is set.
+func (a *actuator) adjust(report *HealthReport) {
+	o, overridden := a.Override()
+
+	if !a.started.Load() && !(overridden && o.Mode == OverrideInService) {
+		if healthy(report.Status) {
+			report.Status = StatusUnknown
+		}
+	}
+
+	if a.draining.Load() {
+		report.Status = StatusOutOfService
+	}
+
+	if overridden {
+		report.Override = &o
+		if o.Mode == OverrideOutOfService {
+			report.Status = StatusOutOfService
+		}
+	}
+}
+
+func (a *actuator) Subscribe() (<-chan HealthEvent, func()) {
+	ch := make(chan HealthEvent, 16)
+
+	a.subMu.Lock()
+	a.subscribers[ch] = struct{}{}
+	a.subMu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			a.subMu.Lock()
+			delete(a.subscribers, ch)
+			a.subMu.Unlock()
+			close(ch)
+		})
+	}
+}
+
+func (a *actuator) publish(e HealthEvent) {
+	a.subMu.Lock()
+	defer a.subMu.Unlock()
+
+	for ch := range a.subscribers {
+		select {
+		case ch <- e:
+		default:
+			slog.Warn("dropped health event for slow subscriber", "component", e.Component)
+		}
+	}
+}
+
+func (a *actuator) Drain(ctx context.Context) error {
+	a.drainOnce.Do(func() {
+		a.draining.Store(true)
+		slog.Info("actuator draining started", "period", a.config.DrainPeriod)
+		timer := a.clock.NewTimer(a.config.DrainPeriod)
+		go func() {
+			<-timer.C()
+			close(a.drained)
+		}()
+	})
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("failed to wait for drain period: %w", ctx.Err())
+	case <-a.drained:
+		return nil
+	}
+}
+
+type endpointParams struct {
+	fx.In
+	Config           ServerConfig
+	Registry         *prometheus.Registry `optional:"true"`
+	Resource         *resource.Resource   `optional:"true"`
+	InfoContributors []InfoContributor    `group:"info"`
+	Level            *slog.LevelVar       `optional:"true"`
+	Authenticator    Authenticator        `optional:"true"`
+	Verifier         xjwt.Verifier        `optional:"true"`
+	Configs          []ConfigEntry        `group:"config"`
+	Router           *echo.Echo           `optional:"true"`
+	Graph            fx.DotGraph          `optional:"true"`
+	Recorder         *LifecycleRecorder   `optional:"true"`
 }
 
 type serverParams struct {
 	fx.In
+	Endpoints  endpointParams
 	Lifecycle  fx.Lifecycle
 	Shutdowner fx.Shutdowner
-	Config     ServerConfig
 }
 
-func (a *actuator) ExposeHTTPEndpoints(p serverParams) error {
+type echoParams struct {
+	fx.In
+	Endpoints endpointParams
+	Echo      *echo.Echo
+	Mount     MountConfig `optional:"true"`
+}
+
+func (p endpointParams) authenticator() (Authenticator, error) {
+	if p.Authenticator != nil {
+		return p.Authenticator, nil
+	}
+
+	var auths anyAuthenticator
+
+	if p.Config.AuthToken != "" {
+		auth, err := NewStaticTokenAuthenticator(p.Config.AuthToken)
+		if err != nil {
+			return nil, err
+		}
+		auths = append(auths, auth)
+	}
+
+	if p.Config.EnableJWT {
+		auth, err := NewJWTAuthenticator(p.Verifier, p.Config.RequiredScopes)
+		if err != nil {
+			return nil, err
+		}
+		auths = append(auths, auth)
+	}
+
+	if p.Config.ClientCAFile != "" {
+		auths = append(auths, NewClientCertAuthenticator())
+	}
+
+	switch len(auths) {
+	case 0:
+		return nil, nil
+	case 1:
+		return auths[0], nil
+	default:
+		return auths, nil
+	}
+}
+
+func (p endpointParams) tlsConfig() (*tls.Config, error) {
+	if p.Config.CertFile == "" {
+		if p.Config.KeyFile != "" || p.Config.ClientCAFile != "" {
+			return nil, errors.New("key file and client CA file require a certificate file")
+		}
+		return nil, nil
+	}
+
+	if p.Config.KeyFile == "" {
+		return nil, errors.New("certificate file requires a key file")
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if p.Config.ClientCAFile != "" {
+		pem, err := os.ReadFile(p.Config.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA file: %s", p.Config.ClientCAFile)
+		}
+		cfg.ClientCAs = pool
+		cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+
+	return cfg, nil
+}
+
+func (a *actuator) handler(p endpointParams) (http.Handler, error) {
+	auth, err := p.authenticator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure authentication: %w", err)
+	}
+
 	mux := http.NewServeMux()
-	mux.HandleFunc("GET /actuator/liveness", a.liveness)
-	mux.HandleFunc("GET /actuator/readiness", a.readiness)
+	sensitive := protect(auth)
+
+	if p.Config.EnablePrometheus {
+		if p.Registry == nil {
+			return nil, fmt.Errorf("prometheus endpoint enabled but no registry provided")
+		}
+		mux.Handle("GET /prometheus", promhttp.HandlerFor(p.Registry, promhttp.HandlerOpts{}))
+	}
+
+	mux.HandleFunc("GET /liveness", a.liveness)
+	mux.HandleFunc("GET /readiness", a.readiness)
+	mux.HandleFunc("GET /startup", a.startup)
+	mux.HandleFunc("GET /health", a.health)
+	mux.HandleFunc("GET /health/{group}", a.healthGroup)
+	mux.Handle("GET /info", newInfoHandler(p.Resource, p.InfoContributors))
+
+	if p.Level != nil {
+		lh := newLoggersHandler(p.Level)
+		mux.Handle("GET /loggers", sensitive(http.HandlerFunc(lh.get)))
+		mux.Handle("POST /loggers", sensitive(http.HandlerFunc(lh.set)))
+	}
+
+	mux.Handle("GET /config", sensitive(&configHandler{entries: append(slices.Clone(p.Configs), NewConfigEntry("actuator", p.Config))}))
+
+	if p.Router != nil {
+		mux.Handle("GET /mappings", sensitive(&mappingsHandler{router: p.Router}))
+	}
+
+	if p.Graph != "" {
+		mux.Handle("GET /fx/graph", sensitive(newGraphHandler(p.Graph)))
+	}
+
+	if p.Recorder != nil {
+		mux.Handle("GET /fx/timings", sensitive(&timingsHandler{recorder: p.Recorder}))
+	}
+
+	mux.Handle("GET /maintenance", sensitive(http.HandlerFunc(a.getOverride)))
+	mux.Handle("POST /maintenance", sensitive(http.HandlerFunc(a.setOverride)))
+	mux.Handle("DELETE /maintenance", sensitive(http.HandlerFunc(a.clearOverride)))
+
+	if p.Config.EnableDiagnostics {
+		registerDiagnostics(mux, sensitive)
+	}
+
+	return mux, nil
+}
+
+func (a *actuator) ExposeHTTPEndpoints(p serverParams) error {
+	tlsCfg, err := p.Endpoints.tlsConfig()
+	if err != nil {
+		return fmt.Errorf("failed to configure TLS: %w", err)
+	}
+
+	handler, err := a.handler(p.Endpoints)
+	if err != nil {
+		return err
+	}
 
 	server := &http.Server{
-		Addr:              net.JoinHostPort(p.Config.Host, strconv.Itoa(p.Config.Port)),
-		Handler:           mux,
+		Addr:              net.JoinHostPort(p.Endpoints.Config.Host, strconv.Itoa(p.Endpoints.Config.Port)),
+		Handler:           http.StripPrefix(defaultPrefix, handler),
+		TLSConfig:         tlsCfg,
 		ReadTimeout:       2 * time.Second,
 		ReadHeaderTimeout: 1 * time.Second,
 		WriteTimeout:      2 * time.Second,
@@ -55,7 +358,13 @@ func (a *actuator) ExposeHTTPEndpoints(p serverParams) error {
 		OnStart: func(_ context.Context) error {
 			slog.Info("actuator server started", "pid", os.Getpid(), "addr", server.Addr)
 			go func() {
-				if err := server.ListenAndServe(); err != http.ErrServerClosed {
+				var err error
+				if tlsCfg != nil {
+					err = server.ListenAndServeTLS(p.Endpoints.Config.CertFile, p.Endpoints.Config.KeyFile)
+				} else {
+					err = server.ListenAndServe()
+				}
+				if err != http.ErrServerClosed {
 					slog.Error("actuator server startup failed", "error", err)
 					p.Shutdowner.Shutdown()
 				}
@@ -63,6 +372,11 @@ func (a *actuator) ExposeHTTPEndpoints(p serverParams) error {
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			// Keep serving 503 readiness for the whole drain window, whichever
+			// server's stop hook runs first.
+			if err := a.Drain(ctx); err != nil {
+				slog.Error("actuator drain interrupted", "error", err)
+			}
 			if err := server.Shutdown(ctx); err != nil {
 				return fmt.Errorf("failed to shudown actuator server: %w", err)
 			}
@@ -73,55 +387,37 @@ func (a *actuator) ExposeHTTPEndpoints(p serverParams) error {
 	return nil
 }
 
-func (a *actuator) monitor(ctx context.Context) {
-	ticker := time.NewTicker(a.config.HealthCheckInterval)
-	defer ticker.Stop()
-
-	var cancelCurrent context.CancelFunc
-
-	for {
-		if cancelCurrent != nil {
-			cancelCurrent()
-		}
-
-		checkCtx, cancel := context.WithCancel(ctx)
-		cancelCurrent = cancel
-
-		go a.healthCheck(checkCtx)
-
-		select {
-		case <-ctx.Done():
-			return
-		case <-ticker.C:
-			continue
-		}
+func (a *actuator) MountEchoEndpoints(p echoParams) error {
+	// The echo server owns the TLS listener, so client certificates cannot be
+	// verified against the actuator's CA file.
+	if p.Endpoints.Config.ClientCAFile != "" {
+		return errors.New("client CA file is not supported when mounting actuator endpoints on echo")
 	}
-}
 
-func (a *actuator) healthCheck(ctx context.Context) {
-	errCh := make(chan error, len(a.healthCheckers))
+	handler, err := a.handler(p.Endpoints)
+	if err != nil {
+		return err
+	}
 
-	var wg sync.WaitGroup
-	for _, hc := range a.healthCheckers {
-		wg.Go(func() {
-			checkCtx, cancel := context.WithTimeout(ctx, a.config.HealthCheckInterval)
-			defer cancel()
-			if err := hc.Check(checkCtx); err != nil {
-				slog.Error(fmt.Sprintf("healthcheck failed for resource: %s", hc.Name()), "error", err)
-				errCh <- err
-			}
-		})
+	prefix := "/" + strings.Trim(p.Mount.Prefix, "/")
+	if prefix == "/" {
+		prefix = defaultPrefix
 	}
 
-	wg.Wait()
-	close(errCh)
+	handler = http.StripPrefix(prefix, handler)
 
-	ready := true
-	for range errCh {
-		ready = false
-	}
+	p.Echo.Pre(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			path := c.Request().URL.Path
+			if path != prefix && !strings.HasPrefix(path, prefix+"/") {
+				return next(c)
+			}
+			handler.ServeHTTP(c.Response(), c.Request())
+			return nil
+		}
+	})
 
-	a.ready.Store(ready)
+	return nil
 }
 
 func (a *actuator) liveness(w http.ResponseWriter, r *http.Request) {
@@ -139,3 +435,65 @@ func (a *actuator) readiness(w http.ResponseWriter, r *http.Request) {
 	}
 	w.WriteHeader(http.StatusServiceUnavailable)
 }
+
+func (a *actuator) startup(w http.ResponseWriter, r *http.Request) {
+	if a.Startup() {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.WriteHeader(http.StatusServiceUnavailable)
+}
+
+func (a *actuator) health(w http.ResponseWriter, r *http.Request) {
+	writeHealth(w, a.Health())
+}
+
+func writeHealth(w http.ResponseWriter, report HealthReport) {
+	status := http.StatusOK
+	if report.Status != StatusUp && report.Status != StatusDegraded {
+		status = http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, status, report)
+}
+
+func protect(auth Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if auth == nil {
+				writeProblem(w, http.StatusForbidden, "no authenticator configured for sensitive actuator endpoints")
+				return
+			}
+			if err := auth.Authenticate(r); err != nil {
+				if errors.Is(err, ErrInsufficientScope) {
+					writeProblem(w, http.StatusForbidden, err.Error())
+					return
+				}
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeProblem(w, http.StatusUnauthorized, err.Error())
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Error("failed to write actuator response", "error", err)
+	}
+}
+
+func writeProblem(w http.ResponseWriter, status int, detail string) {
+	pd := dto.NewProblemDetail(status).
+		WithTitle(http.StatusText(status)).
+		WithDetail(detail)
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(pd); err != nil {
+		slog.Error("failed to write actuator response", "error", err)
+	}
+}
@@ -0,0 +1,52 @@
+package actuatortest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bencoronard/demo-go-common-libs/actuator"
+)
+
+type Checker struct {
+	name  string
+	opts  actuator.CheckerOptions
+	mu    sync.Mutex
+	err   error
+	calls int
+}
+
+func (c *Checker) WithOptions(opts actuator.CheckerOptions) *Checker {
+	c.opts = opts
+	return c
+}
+
+func (c *Checker) Name() string {
+	return c.name
+}
+
+func (c *Checker) Options() actuator.CheckerOptions {
+	return c.opts
+}
+
+func (c *Checker) Check(_ context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls++
+	return c.err
+}
+
+func (c *Checker) Fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.err = err
+}
+
+func (c *Checker) Recover() {
+	c.Fail(nil)
+}
+
+func (c *Checker) Calls() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.calls
+}
@@ -0,0 +1,97 @@
+package actuatortest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bencoronard/demo-go-common-libs/actuator"
+)
+
+// Clock is a manual actuator.Clock. Its timers fire only when the clock is
+// moved past their deadline with Advance or Set.
+type Clock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*timer
+}
+
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *Clock) NewTimer(d time.Duration) actuator.Timer {
+	t := &timer{clock: c, c: make(chan time.Time, 1)}
+
+	c.mu.Lock()
+	c.timers = append(c.timers, t)
+	c.mu.Unlock()
+
+	t.Reset(d)
+	return t
+}
+
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.fire()
+}
+
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+	c.fire()
+}
+
+func (c *Clock) fire() {
+	for _, t := range c.timers {
+		if t.active && !t.deadline.After(c.now) {
+			t.active = false
+			select {
+			case t.c <- c.now:
+			default:
+			}
+		}
+	}
+}
+
+type timer struct {
+	clock    *Clock
+	c        chan time.Time
+	deadline time.Time
+	active   bool
+}
+
+func (t *timer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *timer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.stop()
+}
+
+func (t *timer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := t.stop()
+	t.deadline = t.clock.now.Add(d)
+	t.active = true
+	t.clock.fire()
+	return active
+}
+
+func (t *timer) stop() bool {
+	active := t.active
+	t.active = false
+	select {
+	case <-t.c:
+	default:
+	}
+	return active
+}
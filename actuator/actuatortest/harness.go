@@ -0,0 +1,99 @@
+package actuatortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bencoronard/demo-go-common-libs/actuator"
+)
+
+type Harness struct {
+	tb       testing.TB
+	clock    *Clock
+	actuator actuator.Actuator
+	stepper  actuator.Stepper
+	wake     time.Time
+}
+
+func (h *Harness) Actuator() actuator.Actuator {
+	return h.actuator
+}
+
+func (h *Harness) Clock() *Clock {
+	return h.clock
+}
+
+// Boot marks startup as complete and, like the monitor, immediately runs a
+// round in which every readiness check is due again.
+func (h *Harness) Boot() {
+	h.stepper.Boot()
+	h.Step()
+}
+
+// Step runs a monitor round at the current time.
+func (h *Harness) Step() {
+	wait := h.stepper.Step(h.tb.Context())
+	if wait <= 0 {
+		h.tb.Fatalf("actuator monitor would not sleep between rounds (wait %s)", wait)
+	}
+	h.wake = h.clock.Now().Add(wait)
+}
+
+// Advance moves the clock forward by d, running a round at every point where
+// the monitor's timer would have fired on the way.
+func (h *Harness) Advance(d time.Duration) {
+	target := h.clock.Now().Add(d)
+	for !h.wake.After(target) {
+		h.clock.Set(h.wake)
+		h.Step()
+	}
+	h.clock.Set(target)
+}
+
+func (h *Harness) Health() actuator.HealthReport {
+	return h.actuator.Health()
+}
+
+func (h *Harness) AssertLive(want bool) {
+	h.tb.Helper()
+	if got := h.actuator.Liveness(); got != want {
+		h.tb.Errorf("liveness = %t, want %t", got, want)
+	}
+}
+
+func (h *Harness) AssertReady(want bool) {
+	h.tb.Helper()
+	if got := h.actuator.Readiness(); got != want {
+		h.tb.Errorf("readiness = %t, want %t", got, want)
+	}
+}
+
+func (h *Harness) AssertStarted(want bool) {
+	h.tb.Helper()
+	if got := h.actuator.Startup(); got != want {
+		h.tb.Errorf("startup = %t, want %t", got, want)
+	}
+}
+
+func (h *Harness) AssertStatus(want actuator.Status) {
+	h.tb.Helper()
+	if got := h.actuator.Health().Status; got != want {
+		h.tb.Errorf("health status = %s, want %s", got, want)
+	}
+}
+
+func (h *Harness) AssertComponent(name string, want actuator.Status) {
+	h.tb.Helper()
+
+	report := h.actuator.Health()
+	for _, c := range append(report.Components, report.Liveness...) {
+		if c.Name == name {
+			if c.Status != want {
+				h.tb.Errorf("component %s status = %s, want %s", name, c.Status, want)
+			}
+			return
+		}
+	}
+
+	h.tb.Errorf("component %s not found in health report", name)
+}
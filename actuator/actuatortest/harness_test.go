@@ -0,0 +1,161 @@
+package actuatortest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bencoronard/demo-go-common-libs/actuator"
+	"github.com/bencoronard/demo-go-common-libs/actuator/actuatortest"
+)
+
+func TestReadinessTransition(t *testing.T) {
+	db := actuatortest.NewChecker("db").WithOptions(actuator.CheckerOptions{FailureThreshold: 2})
+	h := actuatortest.New(t, actuatortest.Config{
+		Health:    actuator.HealthCheckConfig{HealthCheckInterval: time.Second},
+		Readiness: []actuator.HealthChecker{db},
+	})
+
+	h.AssertReady(false)
+	h.AssertStatus(actuator.StatusUnknown)
+
+	h.Step()
+	h.AssertReady(true)
+	h.AssertStarted(true)
+	h.AssertStatus(actuator.StatusUp)
+
+	db.Fail(errors.New("connection refused"))
+	h.Advance(time.Second)
+	h.AssertComponent("db", actuator.StatusUp)
+	h.AssertReady(true)
+
+	h.Advance(time.Second)
+	h.AssertComponent("db", actuator.StatusDown)
+	h.AssertReady(false)
+	h.AssertStatus(actuator.StatusDown)
+
+	db.Recover()
+	h.Advance(time.Second)
+	h.AssertReady(true)
+
+	if got := db.Calls(); got != 4 {
+		t.Errorf("calls = %d, want 4", got)
+	}
+}
+
+func TestPerCheckerInterval(t *testing.T) {
+	fast := actuatortest.NewChecker("cache").WithOptions(actuator.CheckerOptions{Interval: 100 * time.Millisecond})
+	slow := actuatortest.NewChecker("db")
+	h := actuatortest.New(t, actuatortest.Config{
+		Health:    actuator.HealthCheckConfig{HealthCheckInterval: 2 * time.Second},
+		Readiness: []actuator.HealthChecker{fast, slow},
+	})
+
+	h.Step()
+	h.Advance(time.Second)
+
+	if got := fast.Calls(); got != 11 {
+		t.Errorf("cache calls = %d, want 11", got)
+	}
+	if got := slow.Calls(); got != 1 {
+		t.Errorf("db calls = %d, want 1", got)
+	}
+}
+
+func TestStartupTracking(t *testing.T) {
+	h := actuatortest.New(t, actuatortest.Config{
+		Readiness:    []actuator.HealthChecker{actuatortest.NewChecker("db")},
+		TrackStartup: true,
+	})
+
+	h.Step()
+	h.AssertComponent("db", actuator.StatusUp)
+	h.AssertStarted(false)
+	h.AssertReady(false)
+	h.AssertStatus(actuator.StatusUnknown)
+
+	h.Boot()
+	h.AssertStarted(true)
+	h.AssertReady(true)
+	h.AssertStatus(actuator.StatusUp)
+}
+
+func TestLivenessFailureThreshold(t *testing.T) {
+	deadlock := actuatortest.NewChecker("deadlock")
+	h := actuatortest.New(t, actuatortest.Config{
+		Health: actuator.HealthCheckConfig{
+			HealthCheckInterval:      time.Second,
+			LivenessCheckInterval:    5 * time.Second,
+			LivenessFailureThreshold: 2,
+		},
+		Liveness: []actuator.HealthChecker{deadlock},
+	})
+
+	h.Step()
+	h.AssertLive(true)
+	h.AssertComponent("actuator_monitor", actuator.StatusUp)
+
+	deadlock.Fail(errors.New("stuck"))
+	h.Advance(5 * time.Second)
+	h.AssertLive(true)
+
+	h.Advance(5 * time.Second)
+	h.AssertLive(false)
+}
+
+func TestLivenessPerCheckerInterval(t *testing.T) {
+	fast := actuatortest.NewChecker("goroutines").WithOptions(actuator.CheckerOptions{Interval: time.Second})
+	slow := actuatortest.NewChecker("deadlock")
+	h := actuatortest.New(t, actuatortest.Config{
+		Health: actuator.HealthCheckConfig{
+			HealthCheckInterval:   time.Second,
+			LivenessCheckInterval: 5 * time.Second,
+		},
+		Liveness: []actuator.HealthChecker{fast, slow},
+	})
+
+	h.Step()
+	h.Advance(5 * time.Second)
+
+	if got := fast.Calls(); got != 6 {
+		t.Errorf("goroutines calls = %d, want 6", got)
+	}
+	if got := slow.Calls(); got != 2 {
+		t.Errorf("deadlock calls = %d, want 2", got)
+	}
+}
+
+func TestReadinessGroupFollowsReadiness(t *testing.T) {
+	h := actuatortest.New(t, actuatortest.Config{
+		Health: actuator.HealthCheckConfig{
+			HealthCheckInterval: time.Second,
+			Groups:              map[string][]string{"readiness": {"db"}},
+		},
+		Readiness:    []actuator.HealthChecker{actuatortest.NewChecker("db")},
+		TrackStartup: true,
+	})
+
+	group := func() actuator.Status {
+		report, _ := h.Actuator().HealthGroup("readiness")
+		return report.Status
+	}
+
+	h.Step()
+	if got := group(); got != actuator.StatusUnknown {
+		t.Errorf("group status before boot = %s, want %s", got, actuator.StatusUnknown)
+	}
+
+	h.Boot()
+	if got := group(); got != actuator.StatusUp {
+		t.Errorf("group status after boot = %s, want %s", got, actuator.StatusUp)
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	_ = h.Actuator().Drain(ctx)
+	h.AssertReady(false)
+	if got := group(); got != actuator.StatusOutOfService {
+		t.Errorf("group status while draining = %s, want %s", got, actuator.StatusOutOfService)
+	}
+}
@@ -0,0 +1,86 @@
+package actuatortest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bencoronard/demo-go-common-libs/actuator"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
+)
+
+const defaultInterval = time.Second
+
+var epoch = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+type Config struct {
+	Health    actuator.HealthCheckConfig
+	Readiness []actuator.HealthChecker
+	Liveness  []actuator.HealthChecker
+	Start     time.Time
+	// TrackStartup gates readiness on Boot, as when the application wires
+	// startup tracking.
+	TrackStartup bool
+}
+
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+func NewChecker(name string) *Checker {
+	return &Checker{name: name}
+}
+
+// New builds an actuator whose fx lifecycle is never started, so check rounds
+// only run when the harness is stepped.
+func New(tb testing.TB, cfg Config) *Harness {
+	tb.Helper()
+
+	if cfg.Start.IsZero() {
+		cfg.Start = epoch
+	}
+
+	if cfg.Health.HealthCheckInterval <= 0 {
+		cfg.Health.HealthCheckInterval = defaultInterval
+	}
+
+	clock := NewClock(cfg.Start)
+
+	var a actuator.Actuator
+	opts := []fx.Option{
+		fx.NopLogger,
+		fx.Supply(cfg.Health),
+		fx.Provide(
+			func() actuator.Clock { return clock },
+			actuator.New,
+		),
+		fx.Populate(&a),
+	}
+	if cfg.TrackStartup {
+		opts = append(opts, fx.Supply(actuator.NewLifecycleRecorder(fxevent.NopLogger)))
+	}
+	opts = append(opts, checkers("healthcheck", cfg.Readiness)...)
+	opts = append(opts, checkers("liveness", cfg.Liveness)...)
+
+	if err := fx.New(opts...).Err(); err != nil {
+		tb.Fatalf("failed to build actuator: %v", err)
+	}
+
+	stepper, ok := a.(actuator.Stepper)
+	if !ok {
+		tb.Fatalf("actuator does not implement actuator.Stepper")
+	}
+
+	return &Harness{tb: tb, clock: clock, actuator: a, stepper: stepper}
+}
+
+func checkers(group string, hcs []actuator.HealthChecker) []fx.Option {
+	opts := make([]fx.Option, len(hcs))
+	for i, hc := range hcs {
+		opts[i] = fx.Provide(fx.Annotate(
+			func() actuator.HealthChecker { return hc },
+			fx.ResultTags(`group:"`+group+`"`),
+		))
+	}
+	return opts
+}
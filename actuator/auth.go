@@ -0,0 +1,113 @@
+package actuator
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	xjwt "github.com/bencoronard/demo-go-common-libs/jwt"
+)
+
+type staticTokenAuthenticator struct {
+	token []byte
+}
+
+func (a *staticTokenAuthenticator) Authenticate(r *http.Request) error {
+	token, err := bearerToken(r)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(token), a.token) != 1 {
+		return ErrCredentialsInvalid
+	}
+	return nil
+}
+
+type jwtAuthenticator struct {
+	verifier xjwt.Verifier
+	scopes   []string
+}
+
+func (a *jwtAuthenticator) Authenticate(r *http.Request) error {
+	token, err := bearerToken(r)
+	if err != nil {
+		return err
+	}
+
+	claims, err := a.verifier.VerifyToken(token)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCredentialsInvalid, err)
+	}
+
+	granted := scopes(claims["scope"])
+	if len(granted) == 0 {
+		granted = scopes(claims["scp"])
+	}
+
+	for _, s := range a.scopes {
+		if !slices.Contains(granted, s) {
+			return fmt.Errorf("%w: %s required", ErrInsufficientScope, s)
+		}
+	}
+
+	return nil
+}
+
+type clientCertAuthenticator struct{}
+
+func (a *clientCertAuthenticator) Authenticate(r *http.Request) error {
+	if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 {
+		return ErrCredentialsMissing
+	}
+	if len(r.TLS.VerifiedChains) == 0 {
+		return ErrCredentialsInvalid
+	}
+	return nil
+}
+
+type anyAuthenticator []Authenticator
+
+func (a anyAuthenticator) Authenticate(r *http.Request) error {
+	err := ErrCredentialsMissing
+	for _, auth := range a {
+		e := auth.Authenticate(r)
+		if e == nil {
+			return nil
+		}
+		if !errors.Is(e, ErrCredentialsMissing) {
+			err = e
+		}
+	}
+	return err
+}
+
+func bearerToken(r *http.Request) (string, error) {
+	prefix := "Bearer "
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+
+	if !strings.HasPrefix(header, prefix) {
+		return "", ErrCredentialsMissing
+	}
+
+	return header[len(prefix):], nil
+}
+
+func scopes(v any) []string {
+	switch s := v.(type) {
+	case string:
+		return strings.Fields(s)
+	case []any:
+		out := make([]string, 0, len(s))
+		for _, e := range s {
+			if str, ok := e.(string); ok {
+				out = append(out, str)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+package actuator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+	rtmetrics "runtime/metrics"
+)
+
+type httpChecker struct {
+	name     string
+	url      string
+	expected int
+	client   *http.Client
+}
+
+func (c *httpChecker) Name() string {
+	return c.name
+}
+
+func (c *httpChecker) Check(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach %s: %w", c.url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+
+	if resp.StatusCode != c.expected {
+		return fmt.Errorf("unexpected status from %s: got %d, want %d", c.url, resp.StatusCode, c.expected)
+	}
+	return nil
+}
+
+type tcpChecker struct {
+	name    string
+	address string
+}
+
+func (c *tcpChecker) Name() string {
+	return c.name
+}
+
+func (c *tcpChecker) Check(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", c.address)
+	if err != nil {
+		return fmt.Errorf("failed to dial %s: %w", c.address, err)
+	}
+	return conn.Close()
+}
+
+type dnsChecker struct {
+	name string
+	host string
+}
+
+func (c *dnsChecker) Name() string {
+	return c.name
+}
+
+func (c *dnsChecker) Check(ctx context.Context) error {
+	addrs, err := net.DefaultResolver.LookupHost(ctx, c.host)
+	if err != nil {
+		return fmt.Errorf("failed to resolve %s: %w", c.host, err)
+	}
+	if len(addrs) == 0 {
+		return fmt.Errorf("no addresses found for %s", c.host)
+	}
+	return nil
+}
+
+type diskSpaceChecker struct {
+	name    string
+	path    string
+	minFree uint64
+}
+
+func (c *diskSpaceChecker) Name() string {
+	return c.name
+}
+
+func (c *diskSpaceChecker) Check(_ context.Context) error {
+	free, err := freeDiskSpace(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read disk space of %s: %w", c.path, err)
+	}
+	if free < c.minFree {
+		return fmt.Errorf("free disk space of %s below threshold: %d < %d bytes", c.path, free, c.minFree)
+	}
+	return nil
+}
+
+type runtimeChecker struct {
+	name          string
+	maxGoroutines int
+	maxHeapBytes  uint64
+}
+
+func (c *runtimeChecker) Name() string {
+	return c.name
+}
+
+func (c *runtimeChecker) Check(_ context.Context) error {
+	if c.maxGoroutines > 0 {
+		if n := runtime.NumGoroutine(); n > c.maxGoroutines {
+			return fmt.Errorf("goroutine count above ceiling: %d > %d", n, c.maxGoroutines)
+		}
+	}
+
+	if c.maxHeapBytes > 0 {
+		s := []rtmetrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+		rtmetrics.Read(s)
+		if s[0].Value.Kind() == rtmetrics.KindUint64 {
+			if heap := s[0].Value.Uint64(); heap > c.maxHeapBytes {
+				return fmt.Errorf("heap size above ceiling: %d > %d bytes", heap, c.maxHeapBytes)
+			}
+		}
+	}
+
+	return nil
+}
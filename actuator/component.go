@@ -0,0 +1,119 @@
+package actuator
+
+import "time"
+
+type configuredChecker struct {
+	HealthChecker
+	opts CheckerOptions
+}
+
+func (c *configuredChecker) Options() CheckerOptions {
+	return c.opts
+}
+
+type component struct {
+	probe     string
+	checker   HealthChecker
+	opts      CheckerOptions
+	health    ComponentHealth
+	failures  int
+	successes int
+	running   bool
+	next      time.Time
+}
+
+func newComponent(probe string, hc HealthChecker, defaults CheckerOptions) *component {
+	opts := defaults
+	if c, ok := hc.(ConfigurableHealthChecker); ok {
+		opts = c.Options()
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = defaults.Interval
+	}
+
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaults.Timeout
+	}
+
+	if opts.FailureThreshold <= 0 {
+		opts.FailureThreshold = 1
+	}
+
+	if opts.SuccessThreshold <= 0 {
+		opts.SuccessThreshold = 1
+	}
+
+	return &component{
+		probe:   probe,
+		checker: hc,
+		opts:    opts,
+		health: ComponentHealth{
+			Name:        hc.Name(),
+			Status:      StatusUnknown,
+			Criticality: opts.Criticality,
+		},
+	}
+}
+
+func newComponents(probe string, checkers []HealthChecker, defaults CheckerOptions) []*component {
+	components := make([]*component, len(checkers))
+	for i, hc := range checkers {
+		components[i] = newComponent(probe, hc, defaults)
+	}
+	return components
+}
+
+func (c *component) record(now time.Time, latency time.Duration, err error) {
+	c.health.Latency = latency
+
+	if err != nil {
+		c.failures++
+		c.successes = 0
+		c.health.Error = err.Error()
+		c.health.LastFailure = now
+		if c.health.Status == StatusUnknown || c.failures >= c.opts.FailureThreshold {
+			c.health.Status = StatusDown
+		}
+		return
+	}
+
+	c.successes++
+	c.failures = 0
+	c.health.Error = ""
+	c.health.LastSuccess = now
+	if c.health.Status == StatusUnknown || c.successes >= c.opts.SuccessThreshold {
+		c.health.Status = StatusUp
+	}
+}
+
+func aggregate(components []ComponentHealth) Status {
+	var down, unknown, degraded bool
+
+	for _, c := range components {
+		switch {
+		case c.Criticality == Informational:
+		case c.Criticality == Degraded:
+			degraded = degraded || c.Status == StatusDown
+		case c.Status == StatusDown:
+			down = true
+		case c.Status == StatusUnknown:
+			unknown = true
+		}
+	}
+
+	switch {
+	case down:
+		return StatusDown
+	case unknown:
+		return StatusUnknown
+	case degraded:
+		return StatusDegraded
+	default:
+		return StatusUp
+	}
+}
+
+func healthy(status Status) bool {
+	return status == StatusUp || status == StatusDegraded
+}
@@ -0,0 +1,111 @@
+package actuator
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	xhttp "github.com/bencoronard/demo-go-common-libs/http"
+	"github.com/labstack/echo/v5"
+)
+
+const masked = "******"
+
+var secretNames = []string{"password", "secret", "token", "key", "credential", "credentials"}
+
+type configHandler struct {
+	entries []ConfigEntry
+}
+
+func (h *configHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	out := make(map[string]any, len(h.entries))
+	for _, e := range h.entries {
+		out[e.Name] = sanitize(reflect.ValueOf(e.Value))
+	}
+	writeJSON(w, http.StatusOK, out)
+}
+
+type mappingsHandler struct {
+	router *echo.Echo
+}
+
+func (h *mappingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, xhttp.Mappings(h.router))
+}
+
+func sanitize(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch t := v.Interface().(type) {
+	case time.Duration:
+		return t.String()
+	case time.Time:
+		return t
+	case fmt.Stringer:
+		if v.Kind() != reflect.Struct && v.Kind() != reflect.Pointer {
+			return t.String()
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return sanitize(v.Elem())
+	case reflect.Struct:
+		out := map[string]any{}
+		t := v.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if isSecret(f.Name) || f.Tag.Get("actuator") == "secret" {
+				out[f.Name] = masked
+				continue
+			}
+			out[f.Name] = sanitize(v.Field(i))
+		}
+		return out
+	case reflect.Map:
+		out := map[string]any{}
+		iter := v.MapRange()
+		for iter.Next() {
+			k := fmt.Sprint(iter.Key().Interface())
+			if isSecret(k) {
+				out[k] = masked
+				continue
+			}
+			out[k] = sanitize(iter.Value())
+		}
+		return out
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return masked
+		}
+		out := make([]any, v.Len())
+		for i := range v.Len() {
+			out[i] = sanitize(v.Index(i))
+		}
+		return out
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return v.Type().String()
+	default:
+		return v.Interface()
+	}
+}
+
+func isSecret(name string) bool {
+	name = strings.ToLower(name)
+	for _, s := range secretNames {
+		if strings.HasSuffix(name, s) {
+			return true
+		}
+	}
+	return false
+}
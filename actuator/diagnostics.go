@@ -0,0 +1,183 @@
+package actuator
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"regexp"
+	"runtime"
+	"runtime/debug"
+	rtmetrics "runtime/metrics"
+	"slices"
+	"strings"
+	"time"
+)
+
+func registerDiagnostics(mux *http.ServeMux, protect func(http.Handler) http.Handler) {
+	handle := func(pattern string, h http.HandlerFunc) {
+		mux.Handle(pattern, protect(withoutWriteDeadline(h)))
+	}
+
+	handle("GET /pprof/", pprof.Index)
+	handle("GET /pprof/cmdline", pprof.Cmdline)
+	handle("GET /pprof/profile", pprof.Profile)
+	handle("GET /pprof/symbol", pprof.Symbol)
+	handle("POST /pprof/symbol", pprof.Symbol)
+	handle("GET /pprof/trace", pprof.Trace)
+	handle("GET /pprof/{profile}", func(w http.ResponseWriter, r *http.Request) {
+		pprof.Handler(r.PathValue("profile")).ServeHTTP(w, r)
+	})
+
+	handle("GET /goroutines", goroutineDump)
+	handle("GET /runtime", runtimeMetrics)
+	handle("POST /gc", forceGC)
+	handle("POST /heapdump", heapDump)
+}
+
+func withoutWriteDeadline(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+			slog.Warn("failed to clear write deadline", "error", err)
+		}
+		h(w, r)
+	}
+}
+
+var (
+	stackOffset  = regexp.MustCompile(` \+0x[0-9a-f]+$`)
+	stackCreator = regexp.MustCompile(` in goroutine \d+$`)
+	stackHeader  = regexp.MustCompile(`^goroutine \d+ \[([^\],]+)`)
+)
+
+func goroutineDump(w http.ResponseWriter, r *http.Request) {
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	groups := map[string]*GoroutineGroup{}
+	total := 0
+
+	for block := range bytes.SplitSeq(buf, []byte("\n\n")) {
+		sc := bufio.NewScanner(bytes.NewReader(block))
+		if !sc.Scan() {
+			continue
+		}
+
+		state := "unknown"
+		if m := stackHeader.FindStringSubmatch(sc.Text()); m != nil {
+			state = m[1]
+		}
+
+		var stack []string
+		for sc.Scan() {
+			stack = append(stack, normalizeFrame(sc.Text()))
+		}
+
+		key := strings.Join(stack, "\n")
+		g, ok := groups[key]
+		if !ok {
+			g = &GoroutineGroup{States: map[string]int{}, Stack: stack}
+			groups[key] = g
+		}
+		g.Count++
+		g.States[state]++
+		total++
+	}
+
+	dump := GoroutineDump{Total: total, Groups: make([]GoroutineGroup, 0, len(groups))}
+	for _, g := range groups {
+		dump.Groups = append(dump.Groups, *g)
+	}
+	slices.SortFunc(dump.Groups, func(a, b GoroutineGroup) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	writeJSON(w, http.StatusOK, dump)
+}
+
+func normalizeFrame(line string) string {
+	if strings.HasPrefix(line, "\t") {
+		return stackOffset.ReplaceAllString(strings.TrimSpace(line), "")
+	}
+
+	if strings.HasPrefix(line, "created by ") {
+		return stackCreator.ReplaceAllString(line, "")
+	}
+
+	if i := strings.LastIndex(line, "("); i > 0 && strings.HasSuffix(line, ")") {
+		return line[:i]
+	}
+
+	return line
+}
+
+func runtimeMetrics(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, readRuntimeMetrics())
+}
+
+func forceGC(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	runtime.GC()
+	debug.FreeOSMemory()
+	slog.Info("forced garbage collection", "duration", time.Since(start))
+
+	writeJSON(w, http.StatusOK, readRuntimeMetrics())
+}
+
+func heapDump(w http.ResponseWriter, r *http.Request) {
+	f, err := os.CreateTemp("", "heapdump-*")
+	if err != nil {
+		writeProblem(w, http.StatusInternalServerError, fmt.Sprintf("failed to create heap dump file: %v", err))
+		return
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	debug.WriteHeapDump(f.Fd())
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		writeProblem(w, http.StatusInternalServerError, fmt.Sprintf("failed to read heap dump file: %v", err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="heapdump-%d"`, time.Now().Unix()))
+	if _, err := io.Copy(w, f); err != nil {
+		slog.Error("failed to write heap dump", "error", err)
+	}
+}
+
+func readRuntimeMetrics() map[string]any {
+	var samples []rtmetrics.Sample
+	for _, d := range rtmetrics.All() {
+		if d.Kind == rtmetrics.KindFloat64Histogram {
+			continue
+		}
+		samples = append(samples, rtmetrics.Sample{Name: d.Name})
+	}
+
+	rtmetrics.Read(samples)
+
+	out := make(map[string]any, len(samples))
+	for _, s := range samples {
+		switch s.Value.Kind() {
+		case rtmetrics.KindUint64:
+			out[s.Name] = s.Value.Uint64()
+		case rtmetrics.KindFloat64:
+			out[s.Name] = s.Value.Float64()
+		}
+	}
+	return out
+}
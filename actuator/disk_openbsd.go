@@ -0,0 +1,12 @@
+package actuator
+
+import "syscall"
+
+func freeDiskSpace(path string) (uint64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	// F_bavail goes negative when non-root usage exceeds the reserve.
+	return uint64(max(st.F_bavail, 0)) * uint64(st.F_bsize), nil
+}
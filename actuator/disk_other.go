@@ -0,0 +1,12 @@
+//go:build !(linux || darwin || freebsd || openbsd)
+
+package actuator
+
+import (
+	"errors"
+	"runtime"
+)
+
+func freeDiskSpace(_ string) (uint64, error) {
+	return 0, errors.New("disk space check not supported on " + runtime.GOOS)
+}
@@ -0,0 +1,197 @@
+package actuator
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusUp           Status = "UP"
+	StatusDown         Status = "DOWN"
+	StatusUnknown      Status = "UNKNOWN"
+	StatusDegraded     Status = "DEGRADED"
+	StatusOutOfService Status = "OUT_OF_SERVICE"
+)
+
+type Criticality int
+
+const (
+	Critical Criticality = iota
+	Degraded
+	Informational
+)
+
+func (c Criticality) String() string {
+	switch c {
+	case Critical:
+		return "critical"
+	case Degraded:
+		return "degraded"
+	case Informational:
+		return "informational"
+	default:
+		return "unknown"
+	}
+}
+
+func (c Criticality) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+type ComponentHealth struct {
+	Name        string
+	Status      Status
+	Criticality Criticality
+	Error       string
+	Latency     time.Duration
+	LastSuccess time.Time
+	LastFailure time.Time
+}
+
+func (c ComponentHealth) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name        string      `json:"name"`
+		Status      Status      `json:"status"`
+		Criticality Criticality `json:"criticality"`
+		Error       string      `json:"error,omitempty"`
+		Latency     string      `json:"latency"`
+		LastSuccess time.Time   `json:"lastSuccess,omitzero"`
+		LastFailure time.Time   `json:"lastFailure,omitzero"`
+	}{
+		Name:        c.Name,
+		Status:      c.Status,
+		Criticality: c.Criticality,
+		Error:       c.Error,
+		Latency:     c.Latency.String(),
+		LastSuccess: c.LastSuccess,
+		LastFailure: c.LastFailure,
+	})
+}
+
+type HealthReport struct {
+	Status     Status            `json:"status"`
+	Components []ComponentHealth `json:"components"`
+	Liveness   []ComponentHealth `json:"liveness,omitempty"`
+	Override   *Override         `json:"override,omitempty"`
+}
+
+type OverrideMode string
+
+const (
+	OverrideOutOfService OverrideMode = "OUT_OF_SERVICE"
+	OverrideInService    OverrideMode = "IN_SERVICE"
+)
+
+type Override struct {
+	Mode      OverrideMode `json:"mode"`
+	Reason    string       `json:"reason,omitempty"`
+	ExpiresAt time.Time    `json:"expiresAt,omitzero"`
+}
+
+type OverrideRequest struct {
+	Mode     OverrideMode `json:"mode"`
+	Reason   string       `json:"reason,omitempty"`
+	Duration string       `json:"duration,omitempty"`
+}
+
+type HealthEvent struct {
+	Component   string      `json:"component"`
+	Probe       string      `json:"probe"`
+	Criticality Criticality `json:"criticality"`
+	From        Status      `json:"from"`
+	To          Status      `json:"to"`
+	Error       string      `json:"error,omitempty"`
+	Time        time.Time   `json:"time"`
+}
+
+type BuildInfo struct {
+	Module       string       `json:"module"`
+	Version      string       `json:"version"`
+	GoVersion    string       `json:"goVersion"`
+	BuildTime    string       `json:"buildTime,omitempty"`
+	VCS          VCSInfo      `json:"vcs"`
+	Dependencies []Dependency `json:"dependencies"`
+}
+
+type VCSInfo struct {
+	System   string `json:"system,omitempty"`
+	Revision string `json:"revision,omitempty"`
+	Time     string `json:"time,omitempty"`
+	Modified bool   `json:"modified"`
+}
+
+type Dependency struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+	Replace string `json:"replace,omitempty"`
+}
+
+type LogLevelRequest struct {
+	Level    string `json:"level"`
+	Duration string `json:"duration,omitempty"`
+}
+
+type LogLevelState struct {
+	Level        string    `json:"level"`
+	DefaultLevel string    `json:"defaultLevel"`
+	RevertAt     time.Time `json:"revertAt,omitzero"`
+}
+
+type GoroutineDump struct {
+	Total  int              `json:"total"`
+	Groups []GoroutineGroup `json:"groups"`
+}
+
+type GoroutineGroup struct {
+	Count  int            `json:"count"`
+	States map[string]int `json:"states"`
+	Stack  []string       `json:"stack"`
+}
+
+type HookTiming struct {
+	Function string
+	Caller   string
+	Duration time.Duration
+	Error    string
+}
+
+func (h HookTiming) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Function string `json:"function"`
+		Caller   string `json:"caller"`
+		Duration string `json:"duration"`
+		Error    string `json:"error,omitempty"`
+	}{
+		Function: h.Function,
+		Caller:   h.Caller,
+		Duration: h.Duration.String(),
+		Error:    h.Error,
+	})
+}
+
+type LifecycleTimings struct {
+	StartedAt time.Time    `json:"startedAt,omitzero"`
+	Startup   string       `json:"startup,omitempty"`
+	OnStart   []HookTiming `json:"onStart"`
+	OnStop    []HookTiming `json:"onStop"`
+}
+
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+type GraphNode struct {
+	ID      string `json:"id"`
+	Label   string `json:"label"`
+	Kind    string `json:"kind"`
+	Package string `json:"package,omitempty"`
+}
+
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
@@ -0,0 +1,9 @@
+package actuator
+
+import "errors"
+
+var (
+	ErrCredentialsMissing = errors.New("missing credentials")
+	ErrCredentialsInvalid = errors.New("invalid credentials")
+	ErrInsufficientScope  = errors.New("insufficient scope")
+)
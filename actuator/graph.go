@@ -0,0 +1,127 @@
+package actuator
+
+import (
+	"bufio"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.uber.org/fx"
+)
+
+var (
+	dotCluster = regexp.MustCompile(`^subgraph cluster_\d+ \{$`)
+	dotLabel   = regexp.MustCompile(`^label = "(.*)";$`)
+	dotEdge    = regexp.MustCompile(`^("[^"]*"|\S+) -> ("[^"]*"|\S+)`)
+	dotNode    = regexp.MustCompile(`^("[^"]*"|\S+) \[(.*)\];$`)
+	dotShape   = regexp.MustCompile(`shape=(\w+)`)
+	dotText    = regexp.MustCompile(`label=("(?:[^"\\]|\\.)*"|<.*>)(?:\s|$)`)
+	htmlTag    = regexp.MustCompile(`<[^>]*>`)
+)
+
+type graphHandler struct {
+	dot   fx.DotGraph
+	graph DependencyGraph
+}
+
+func newGraphHandler(dot fx.DotGraph) *graphHandler {
+	return &graphHandler{dot: dot, graph: parseDotGraph(string(dot))}
+}
+
+func (h *graphHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Query().Get("format") {
+	case "", "json":
+		writeJSON(w, http.StatusOK, h.graph)
+	case "dot":
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(h.dot))
+	default:
+		writeProblem(w, http.StatusBadRequest, "unsupported graph format, expected json or dot")
+	}
+}
+
+// parseDotGraph reads the DOT emitted by dig: each cluster holds one
+// constructor followed by the types it provides, and edges point from a
+// constructor (or value group) to the types it depends on.
+func parseDotGraph(dot string) DependencyGraph {
+	graph := DependencyGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+
+	var (
+		inCluster   bool
+		pkg         string
+		constructor string
+		groups      = map[string]bool{}
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(dot))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case dotCluster.MatchString(line):
+			inCluster, pkg, constructor = true, "", ""
+		case line == "}":
+			inCluster = false
+		case inCluster && dotLabel.MatchString(line):
+			pkg = unquote(`"` + dotLabel.FindStringSubmatch(line)[1] + `"`)
+		case dotEdge.MatchString(line):
+			m := dotEdge.FindStringSubmatch(line)
+			from := unquote(m[1])
+			kind := "dependsOn"
+			if groups[from] {
+				kind = "contains"
+			}
+			graph.Edges = append(graph.Edges, GraphEdge{From: from, To: unquote(m[2]), Kind: kind})
+		case dotNode.MatchString(line):
+			m := dotNode.FindStringSubmatch(line)
+			label := dotText.FindStringSubmatch(m[2])
+			if label == nil {
+				// Nodes restated only to color dependency failures.
+				continue
+			}
+
+			id := unquote(m[1])
+			node := GraphNode{ID: id, Label: labelText(label[1]), Kind: "type"}
+			if shape := dotShape.FindStringSubmatch(m[2]); shape != nil {
+				switch shape[1] {
+				case "plaintext":
+					node.Kind = "constructor"
+				case "diamond":
+					node.Kind = "group"
+					groups[id] = true
+				}
+			}
+
+			if inCluster {
+				node.Package = pkg
+				if node.Kind == "constructor" {
+					constructor = id
+				} else if constructor != "" {
+					graph.Edges = append(graph.Edges, GraphEdge{From: constructor, To: id, Kind: "provides"})
+				}
+			}
+
+			graph.Nodes = append(graph.Nodes, node)
+		}
+	}
+
+	return graph
+}
+
+func unquote(s string) string {
+	if v, err := strconv.Unquote(s); err == nil {
+		return v
+	}
+	return s
+}
+
+func labelText(label string) string {
+	if strings.HasPrefix(label, "<") {
+		html := label[1 : len(label)-1]
+		html = strings.ReplaceAll(html, "<BR />", " ")
+		return strings.TrimSpace(htmlTag.ReplaceAllString(html, ""))
+	}
+	return unquote(label)
+}
@@ -0,0 +1,59 @@
+package actuator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const readinessGroup = "readiness"
+
+func newGroups(components []*component, groups map[string][]string) (map[string][]*component, error) {
+	byName := make(map[string][]*component, len(components))
+	for _, c := range components {
+		byName[c.health.Name] = append(byName[c.health.Name], c)
+	}
+
+	out := make(map[string][]*component, len(groups))
+	for group, names := range groups {
+		members := []*component{}
+		for _, name := range names {
+			cs, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown health checker %s in group %s", name, group)
+			}
+			members = append(members, cs...)
+		}
+		out[group] = members
+	}
+
+	return out, nil
+}
+
+func (a *actuator) HealthGroup(name string) (HealthReport, bool) {
+	members, ok := a.groups[name]
+	if !ok {
+		return HealthReport{}, false
+	}
+
+	components := a.snapshot(members)
+
+	report := HealthReport{
+		Status:     aggregate(components),
+		Components: components,
+	}
+	a.adjust(&report)
+
+	return report, true
+}
+
+func (a *actuator) healthGroup(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("group")
+
+	report, ok := a.HealthGroup(name)
+	if !ok {
+		writeProblem(w, http.StatusNotFound, fmt.Sprintf("unknown health group: %s", name))
+		return
+	}
+
+	writeHealth(w, report)
+}
@@ -0,0 +1,83 @@
+package actuator
+
+import (
+	"maps"
+	"net/http"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// BuildTime is expected to be set at link time, e.g.
+// -ldflags "-X github.com/bencoronard/demo-go-common-libs/actuator.BuildTime=...".
+var BuildTime string
+
+type infoHandler struct {
+	static       map[string]any
+	contributors []InfoContributor
+}
+
+func newInfoHandler(res *resource.Resource, contributors []InfoContributor) *infoHandler {
+	static := map[string]any{}
+
+	if build, ok := readBuildInfo(); ok {
+		static["build"] = build
+	}
+
+	if res != nil {
+		attrs := make(map[string]string, res.Len())
+		for _, kv := range res.Attributes() {
+			attrs[string(kv.Key)] = kv.Value.Emit()
+		}
+		static["resource"] = attrs
+	}
+
+	return &infoHandler{static: static, contributors: contributors}
+}
+
+func (h *infoHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	info := maps.Clone(h.static)
+	for _, c := range h.contributors {
+		info[c.Name()] = c.Info()
+	}
+
+	writeJSON(w, http.StatusOK, info)
+}
+
+func readBuildInfo() (BuildInfo, bool) {
+	bi, ok := debug.ReadBuildInfo()
+	if !ok {
+		return BuildInfo{}, false
+	}
+
+	info := BuildInfo{
+		Module:       bi.Main.Path,
+		Version:      bi.Main.Version,
+		GoVersion:    bi.GoVersion,
+		BuildTime:    BuildTime,
+		Dependencies: make([]Dependency, 0, len(bi.Deps)),
+	}
+
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs":
+			info.VCS.System = s.Value
+		case "vcs.revision":
+			info.VCS.Revision = s.Value
+		case "vcs.time":
+			info.VCS.Time = s.Value
+		case "vcs.modified":
+			info.VCS.Modified = s.Value == "true"
+		}
+	}
+
+	for _, d := range bi.Deps {
+		dep := Dependency{Path: d.Path, Version: d.Version}
+		if d.Replace != nil {
+			dep.Replace = d.Replace.Path + "@" + d.Replace.Version
+		}
+		info.Dependencies = append(info.Dependencies, dep)
+	}
+
+	return info, true
+}
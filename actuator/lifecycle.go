@@ -0,0 +1,129 @@
+package actuator
+
+import (
+	"cmp"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/fx/fxevent"
+)
+
+const summaryHooks = 5
+
+type LifecycleRecorder struct {
+	logger    fxevent.Logger
+	created   time.Time
+	mu        sync.RWMutex
+	startedAt time.Time
+	onStart   []HookTiming
+	onStop    []HookTiming
+	started   []func()
+}
+
+func (r *LifecycleRecorder) LogEvent(event fxevent.Event) {
+	switch e := event.(type) {
+	case *fxevent.OnStartExecuted:
+		r.mu.Lock()
+		r.onStart = append(r.onStart, newHookTiming(e.FunctionName, e.CallerName, e.Runtime, e.Err))
+		r.mu.Unlock()
+	case *fxevent.OnStopExecuted:
+		r.mu.Lock()
+		r.onStop = append(r.onStop, newHookTiming(e.FunctionName, e.CallerName, e.Runtime, e.Err))
+		r.mu.Unlock()
+	case *fxevent.Started:
+		r.mu.Lock()
+		r.startedAt = time.Now()
+		r.mu.Unlock()
+		if e.Err == nil {
+			r.logSummary()
+			r.mu.RLock()
+			started := slices.Clone(r.started)
+			r.mu.RUnlock()
+			for _, fn := range started {
+				fn()
+			}
+		}
+	}
+
+	if r.logger != nil {
+		r.logger.LogEvent(event)
+	}
+}
+
+// onStarted registers fn to run once every OnStart hook has completed.
+func (r *LifecycleRecorder) onStarted(fn func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started = append(r.started, fn)
+}
+
+func (r *LifecycleRecorder) Timings() LifecycleTimings {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t := LifecycleTimings{
+		StartedAt: r.startedAt,
+		OnStart:   slices.Clone(r.onStart),
+		OnStop:    slices.Clone(r.onStop),
+	}
+	if !r.startedAt.IsZero() {
+		t.Startup = r.startedAt.Sub(r.created).String()
+	}
+	if t.OnStart == nil {
+		t.OnStart = []HookTiming{}
+	}
+	if t.OnStop == nil {
+		t.OnStop = []HookTiming{}
+	}
+
+	return t
+}
+
+func (r *LifecycleRecorder) logSummary() {
+	t := r.Timings()
+
+	var total time.Duration
+	for _, h := range t.OnStart {
+		total += h.Duration
+	}
+
+	slowest := slices.SortedFunc(slices.Values(t.OnStart), func(a, b HookTiming) int {
+		return cmp.Compare(b.Duration, a.Duration)
+	})
+	if len(slowest) > summaryHooks {
+		slowest = slowest[:summaryHooks]
+	}
+
+	summary := make([]string, 0, len(slowest))
+	for _, h := range slowest {
+		caller := h.Caller[strings.LastIndex(h.Caller, "/")+1:]
+		summary = append(summary, caller+"="+h.Duration.String())
+	}
+
+	slog.Info("application startup timing",
+		"startup", t.Startup,
+		"hooks", len(t.OnStart),
+		"hooks_duration", total,
+		"slowest", summary,
+	)
+}
+
+func newHookTiming(function, caller string, runtime time.Duration, err error) HookTiming {
+	h := HookTiming{Function: function, Caller: caller, Duration: runtime}
+	if err != nil {
+		h.Error = err.Error()
+	}
+	return h
+}
+
+type timingsHandler struct {
+	recorder *LifecycleRecorder
+}
+
+func (h *timingsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.recorder.Timings())
+}
@@ -0,0 +1,97 @@
+package actuator
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type loggersHandler struct {
+	level    *slog.LevelVar
+	base     slog.Level
+	mu       sync.Mutex
+	timer    *time.Timer
+	revertAt time.Time
+}
+
+func newLoggersHandler(level *slog.LevelVar) *loggersHandler {
+	return &loggersHandler{level: level, base: level.Level()}
+}
+
+func (h *loggersHandler) get(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.state())
+}
+
+func (h *loggersHandler) set(w http.ResponseWriter, r *http.Request) {
+	var req LogLevelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(req.Level)); err != nil {
+		writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid level: %s", req.Level))
+		return
+	}
+
+	var ttl time.Duration
+	if req.Duration != "" {
+		d, err := time.ParseDuration(req.Duration)
+		if err != nil || d <= 0 {
+			writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid duration: %s", req.Duration))
+			return
+		}
+		ttl = d
+	}
+
+	h.apply(level, ttl)
+
+	writeJSON(w, http.StatusOK, h.state())
+}
+
+func (h *loggersHandler) apply(level slog.Level, ttl time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.timer != nil {
+		h.timer.Stop()
+		h.timer = nil
+		h.revertAt = time.Time{}
+	}
+
+	slog.Info("log level changed", "from", h.level.Level(), "to", level, "duration", ttl)
+	h.level.Set(level)
+
+	if ttl <= 0 {
+		h.base = level
+		return
+	}
+
+	h.revertAt = time.Now().Add(ttl)
+	h.timer = time.AfterFunc(ttl, h.revert)
+}
+
+func (h *loggersHandler) revert() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.level.Set(h.base)
+	h.timer = nil
+	h.revertAt = time.Time{}
+	slog.Info("log level reverted", "to", h.base)
+}
+
+func (h *loggersHandler) state() LogLevelState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return LogLevelState{
+		Level:        h.level.Level().String(),
+		DefaultLevel: h.base.String(),
+		RevertAt:     h.revertAt,
+	}
+}
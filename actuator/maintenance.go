@@ -0,0 +1,82 @@
+package actuator
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+func (a *actuator) SetOverride(o Override) error {
+	if o.Mode != OverrideOutOfService && o.Mode != OverrideInService {
+		return fmt.Errorf("unsupported override mode: %s", o.Mode)
+	}
+
+	a.override.Store(&o)
+	slog.Warn("readiness override set", "mode", o.Mode, "reason", o.Reason, "expires_at", o.ExpiresAt)
+
+	return nil
+}
+
+func (a *actuator) ClearOverride() {
+	if a.override.Swap(nil) != nil {
+		slog.Info("readiness override cleared")
+	}
+}
+
+func (a *actuator) Override() (Override, bool) {
+	o := a.override.Load()
+	if o == nil {
+		return Override{}, false
+	}
+
+	if !o.ExpiresAt.IsZero() && !a.clock.Now().Before(o.ExpiresAt) {
+		if a.override.CompareAndSwap(o, nil) {
+			slog.Info("readiness override expired", "mode", o.Mode, "reason", o.Reason)
+		}
+		return Override{}, false
+	}
+
+	return *o, true
+}
+
+func (a *actuator) getOverride(w http.ResponseWriter, r *http.Request) {
+	o, ok := a.Override()
+	if !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (a *actuator) setOverride(w http.ResponseWriter, r *http.Request) {
+	var req OverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return
+	}
+
+	o := Override{Mode: req.Mode, Reason: req.Reason}
+
+	if req.Duration != "" {
+		d, err := time.ParseDuration(req.Duration)
+		if err != nil || d <= 0 {
+			writeProblem(w, http.StatusBadRequest, fmt.Sprintf("invalid duration: %s", req.Duration))
+			return
+		}
+		o.ExpiresAt = a.clock.Now().Add(d)
+	}
+
+	if err := a.SetOverride(o); err != nil {
+		writeProblem(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, o)
+}
+
+func (a *actuator) clearOverride(w http.ResponseWriter, r *http.Request) {
+	a.ClearOverride()
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,105 @@
+package actuator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+const (
+	probeReadiness = "readiness"
+	probeLiveness  = "liveness"
+)
+
+type metrics struct {
+	transitions otelmetric.Int64Counter
+	duration    otelmetric.Float64Histogram
+}
+
+func newMetrics(mp *metric.MeterProvider, a *actuator) (*metrics, error) {
+	meter := mp.Meter("github.com/bencoronard/demo-go-common-libs/actuator")
+
+	status, err := meter.Int64ObservableGauge(
+		"health.component.status",
+		otelmetric.WithDescription("Health status of a component: 1 when UP, 0 otherwise"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create status gauge: %w", err)
+	}
+
+	transitions, err := meter.Int64Counter(
+		"health.component.transitions",
+		otelmetric.WithDescription("Number of health status transitions of a component"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create transition counter: %w", err)
+	}
+
+	duration, err := meter.Float64Histogram(
+		"health.check.duration",
+		otelmetric.WithDescription("Duration of health checks"),
+		otelmetric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create duration histogram: %w", err)
+	}
+
+	_, err = meter.RegisterCallback(func(_ context.Context, o otelmetric.Observer) error {
+		for _, components := range [][]*component{a.components, a.livenessComponents} {
+			for i, h := range a.snapshot(components) {
+				var v int64
+				if h.Status == StatusUp {
+					v = 1
+				}
+				o.ObserveInt64(status, v, otelmetric.WithAttributes(componentAttrs(components[i])...))
+			}
+		}
+		return nil
+	}, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register status callback: %w", err)
+	}
+
+	return &metrics{
+		transitions: transitions,
+		duration:    duration,
+	}, nil
+}
+
+func (m *metrics) recordCheck(ctx context.Context, c *component, d time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+
+	attrs := append(componentAttrs(c), attribute.String("outcome", outcome))
+	m.duration.Record(context.WithoutCancel(ctx), d.Seconds(), otelmetric.WithAttributes(attrs...))
+}
+
+func (m *metrics) recordTransition(ctx context.Context, c *component, from, to Status) {
+	if m == nil {
+		return
+	}
+
+	attrs := append(componentAttrs(c),
+		attribute.String("from", string(from)),
+		attribute.String("to", string(to)),
+	)
+	m.transitions.Add(context.WithoutCancel(ctx), 1, otelmetric.WithAttributes(attrs...))
+}
+
+func componentAttrs(c *component) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("component", c.health.Name),
+		attribute.String("probe", c.probe),
+		attribute.String("criticality", c.opts.Criticality.String()),
+	}
+}
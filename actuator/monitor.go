@@ -0,0 +1,270 @@
+package actuator
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+func (a *actuator) monitor(ctx context.Context) {
+	timer := a.clock.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C():
+		case <-a.refresh:
+			a.reschedule()
+		case <-a.wake:
+		}
+
+		timer.Reset(a.round(func(c *component) {
+			go a.healthCheck(ctx, c)
+		}))
+	}
+}
+
+// Step runs the same rounds as the monitor goroutines. The due checks only
+// start once round has computed its wait, as they would still be running in
+// the monitor, and Step waits for them before returning.
+func (a *actuator) Step(ctx context.Context) time.Duration {
+	select {
+	case <-a.refresh:
+		a.reschedule()
+	default:
+	}
+
+	var due []*component
+	wait := a.round(func(c *component) {
+		due = append(due, c)
+	})
+
+	var wg sync.WaitGroup
+	for _, c := range due {
+		wg.Go(func() {
+			a.healthCheck(ctx, c)
+		})
+	}
+	wg.Wait()
+
+	return min(wait, a.livenessStep(ctx))
+}
+
+// round runs one scheduler iteration at the clock's current time, handing each
+// due component to run, and returns the time until the next one is due.
+func (a *actuator) round(run func(c *component)) time.Duration {
+	now := a.clock.Now()
+	a.heartbeat.Store(now.UnixNano())
+
+	for _, c := range a.schedule(a.components, now) {
+		run(c)
+	}
+
+	a.evaluate()
+
+	return a.wait(a.components, now)
+}
+
+// wait returns the time until the next component is due. Components that are
+// still running past their next run time are left out; healthCheck wakes the
+// monitor when they complete.
+func (a *actuator) wait(components []*component, now time.Time) time.Duration {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	wait := a.config.HealthCheckInterval
+	for _, c := range components {
+		d := c.next.Sub(now)
+		if c.running && d <= 0 {
+			continue
+		}
+		wait = max(min(wait, d), 0)
+	}
+	return wait
+}
+
+func (a *actuator) reschedule() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, c := range a.components {
+		c.next = time.Time{}
+	}
+}
+
+func (a *actuator) schedule(components []*component, now time.Time) []*component {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var due []*component
+	for _, c := range components {
+		if c.running || now.Before(c.next) {
+			continue
+		}
+		c.running = true
+		c.next = now.Add(c.opts.Interval)
+		if c.opts.Jitter > 0 {
+			c.next = c.next.Add(rand.N(c.opts.Jitter))
+		}
+		due = append(due, c)
+	}
+	return due
+}
+
+func (a *actuator) healthCheck(ctx context.Context, c *component) {
+	a.check(ctx, c)
+
+	a.mu.Lock()
+	c.running = false
+	overdue := !a.clock.Now().Before(c.next)
+	a.mu.Unlock()
+
+	a.evaluate()
+
+	if overdue {
+		select {
+		case a.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (a *actuator) evaluate() {
+	members := a.components
+	if g, ok := a.groups[readinessGroup]; ok {
+		members = g
+	}
+
+	ready := healthy(aggregate(a.snapshot(members)))
+	a.ready.Store(ready)
+
+	booted := a.booted.Load() || !a.tracked.Load()
+	if ready && booted && !a.started.Swap(true) {
+		slog.Info("actuator startup completed")
+	}
+}
+
+func (a *actuator) monitorLiveness(ctx context.Context) {
+	timer := a.clock.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C():
+		}
+
+		timer.Reset(a.livenessStep(ctx))
+	}
+}
+
+// livenessStep runs the liveness checkers that are due, each on its own
+// interval, and returns the time until the next one is.
+func (a *actuator) livenessStep(ctx context.Context) time.Duration {
+	if due := a.schedule(a.livenessComponents, a.clock.Now()); len(due) > 0 {
+		a.livenessRound(ctx, due)
+	}
+	return a.wait(a.livenessComponents, a.clock.Now())
+}
+
+// livenessRound counts a failure when one of the due checkers is down and
+// resets the count once every liveness checker is up again.
+func (a *actuator) livenessRound(ctx context.Context, due []*component) {
+	threshold := a.config.LivenessFailureThreshold
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	a.runChecks(ctx, due)
+
+	switch {
+	case healthy(aggregate(a.snapshot(a.livenessComponents))):
+		a.livenessFailures = 0
+	case !healthy(aggregate(a.snapshot(due))):
+		a.livenessFailures++
+	}
+
+	if a.livenessFailures >= threshold && a.alive.Load() {
+		slog.Error("liveness check failure threshold reached", "failures", a.livenessFailures)
+	}
+
+	a.alive.Store(a.livenessFailures < threshold)
+}
+
+func (a *actuator) livenessInterval() time.Duration {
+	if a.config.LivenessCheckInterval > 0 {
+		return a.config.LivenessCheckInterval
+	}
+	return a.config.HealthCheckInterval
+}
+
+func (a *actuator) runChecks(ctx context.Context, components []*component) {
+	var wg sync.WaitGroup
+	for _, c := range components {
+		wg.Go(func() {
+			a.check(ctx, c)
+
+			a.mu.Lock()
+			c.running = false
+			a.mu.Unlock()
+		})
+	}
+
+	wg.Wait()
+}
+
+func (a *actuator) check(ctx context.Context, c *component) {
+	checkCtx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+	defer cancel()
+
+	start := a.clock.Now()
+	err := c.checker.Check(checkCtx)
+	now := a.clock.Now()
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("healthcheck failed for resource: %s", c.checker.Name()), "error", err, "criticality", c.opts.Criticality)
+	}
+
+	a.mu.Lock()
+	from := c.health.Status
+	c.record(now, now.Sub(start), err)
+	health := c.health
+	a.mu.Unlock()
+
+	a.metrics.recordCheck(ctx, c, now.Sub(start), err)
+
+	if from == health.Status {
+		return
+	}
+
+	slog.Info(fmt.Sprintf("health status changed for resource: %s", health.Name), "from", from, "to", health.Status)
+
+	a.metrics.recordTransition(ctx, c, from, health.Status)
+
+	a.publish(HealthEvent{
+		Component:   health.Name,
+		Probe:       c.probe,
+		Criticality: health.Criticality,
+		From:        from,
+		To:          health.Status,
+		Error:       health.Error,
+		Time:        now,
+	})
+}
+
+func (a *actuator) snapshot(components []*component) []ComponentHealth {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	s := make([]ComponentHealth, len(components))
+	for i, c := range components {
+		s[i] = c.health
+	}
+	return s
+}
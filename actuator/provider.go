@@ -1,16 +1,77 @@
+// Package actuator exposes health probes and operational endpoints for fx
+// applications.
+//
+// Startup is untracked by default: unless the application invokes
+// TrackStartup or supplies a LifecycleRecorder, startup completes and
+// readiness turns on with the first ready check round, which may come while
+// later OnStart hooks are still running.
 package actuator
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
 	"time"
 
+	xjwt "github.com/bencoronard/demo-go-common-libs/jwt"
+	"go.opentelemetry.io/otel/sdk/metric"
 	"go.uber.org/fx"
+	"go.uber.org/fx/fxevent"
 )
 
 type Actuator interface {
 	Liveness() bool
 	Readiness() bool
+	Startup() bool
+	Health() HealthReport
+	HealthGroup(name string) (HealthReport, bool)
+	Subscribe() (<-chan HealthEvent, func())
+	Drain(ctx context.Context) error
+	SetOverride(o Override) error
+	ClearOverride()
+	Override() (Override, bool)
 	ExposeHTTPEndpoints(p serverParams) error
+	MountEchoEndpoints(p echoParams) error
+	// TrackStartup gates readiness on application startup. Its OnStart hook
+	// marks startup complete, so it must be the last fx.Invoke; supplying a
+	// LifecycleRecorder instead detects the end of startup regardless of
+	// invoke order. Without either, startup completes with the first ready
+	// check round.
+	TrackStartup(p startupParams) error
+}
+
+const defaultPrefix = "/actuator"
+
+// Clock supplies the time and timers used for check scheduling, draining,
+// latencies and status timestamps. It defaults to the system clock.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer follows the semantics of time.Timer: after Stop or Reset returns, no
+// stale value is received from C.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Stepper drives an actuator whose fx lifecycle has not been started, so that
+// tests can run check rounds synchronously; see package actuatortest.
+type Stepper interface {
+	// Boot marks application startup as complete, as TrackStartup does once
+	// every OnStart hook has run.
+	Boot()
+	// Step runs the monitor rounds due at the clock's current time, waits for
+	// their checks to complete and returns the time until the monitor would
+	// next wake up.
+	Step(ctx context.Context) time.Duration
 }
 
 type HealthChecker interface {
@@ -18,33 +79,290 @@ type HealthChecker interface {
 	Check(ctx context.Context) error
 }
 
+type ConfigEntry struct {
+	Name  string
+	Value any
+}
+
+// NewConfigEntry names a configuration value for the config endpoint, which
+// serves every entry in the "config" value group alongside its own
+// ServerConfig, e.g.
+//
+//	fx.Provide(fx.Annotate(rdb.NewConfigEntry, fx.ResultTags(`group:"config"`)))
+//
+// Fields whose names end in a secret word such as Password or Key, byte
+// slices and fields tagged actuator:"secret" are masked.
+func NewConfigEntry(name string, value any) ConfigEntry {
+	return ConfigEntry{Name: name, Value: value}
+}
+
+type InfoContributor interface {
+	Name() string
+	Info() any
+}
+
+type CheckerOptions struct {
+	Interval         time.Duration
+	Timeout          time.Duration
+	Jitter           time.Duration
+	FailureThreshold int
+	SuccessThreshold int
+	Criticality      Criticality
+}
+
+type ConfigurableHealthChecker interface {
+	HealthChecker
+	Options() CheckerOptions
+}
+
+func WithOptions(hc HealthChecker, opts CheckerOptions) HealthChecker {
+	return &configuredChecker{HealthChecker: hc, opts: opts}
+}
+
+type HTTPCheckConfig struct {
+	Name           string
+	URL            string
+	ExpectedStatus int
+}
+
+func NewHTTPChecker(cfg HTTPCheckConfig) (HealthChecker, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid URL: %s", cfg.URL)
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = fmt.Sprintf("http_%s", u.Host)
+	}
+
+	expected := cfg.ExpectedStatus
+	if expected == 0 {
+		expected = http.StatusOK
+	}
+
+	return &httpChecker{
+		name:     name,
+		url:      cfg.URL,
+		expected: expected,
+		client:   &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }},
+	}, nil
+}
+
+type TCPCheckConfig struct {
+	Name    string
+	Address string
+}
+
+func NewTCPChecker(cfg TCPCheckConfig) (HealthChecker, error) {
+	if _, _, err := net.SplitHostPort(cfg.Address); err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = fmt.Sprintf("tcp_%s", cfg.Address)
+	}
+
+	return &tcpChecker{name: name, address: cfg.Address}, nil
+}
+
+type DNSCheckConfig struct {
+	Name string
+	Host string
+}
+
+func NewDNSChecker(cfg DNSCheckConfig) (HealthChecker, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("host must not be empty")
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = fmt.Sprintf("dns_%s", cfg.Host)
+	}
+
+	return &dnsChecker{name: name, host: cfg.Host}, nil
+}
+
+type DiskSpaceCheckConfig struct {
+	Name         string
+	Path         string
+	MinFreeBytes uint64
+}
+
+func NewDiskSpaceChecker(cfg DiskSpaceCheckConfig) (HealthChecker, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("path must not be empty")
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = fmt.Sprintf("disk_%s", cfg.Path)
+	}
+
+	return &diskSpaceChecker{name: name, path: cfg.Path, minFree: cfg.MinFreeBytes}, nil
+}
+
+type RuntimeCheckConfig struct {
+	Name          string
+	MaxGoroutines int
+	MaxHeapBytes  uint64
+}
+
+func NewRuntimeChecker(cfg RuntimeCheckConfig) (HealthChecker, error) {
+	if cfg.MaxGoroutines <= 0 && cfg.MaxHeapBytes == 0 {
+		return nil, errors.New("at least one ceiling must be set")
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = "runtime"
+	}
+
+	return &runtimeChecker{name: name, maxGoroutines: cfg.MaxGoroutines, maxHeapBytes: cfg.MaxHeapBytes}, nil
+}
+
 type HealthCheckConfig struct {
-	HealthCheckInterval time.Duration
-	HealthCheckTimeout  time.Duration
+	HealthCheckInterval      time.Duration
+	HealthCheckTimeout       time.Duration
+	LivenessCheckInterval    time.Duration
+	LivenessFailureThreshold int
+	DrainPeriod              time.Duration
+	// Groups maps a group name to the names of its health checkers. A group
+	// named "readiness" restricts which checkers drive readiness.
+	Groups map[string][]string
 }
 
 type ServerConfig struct {
-	Host string
-	Port int
+	Host              string
+	Port              int
+	EnablePrometheus  bool
+	EnableDiagnostics bool
+	AuthToken         string
+	EnableJWT         bool
+	RequiredScopes    []string
+	CertFile          string
+	KeyFile           string
+	ClientCAFile      string
+}
+
+type MountConfig struct {
+	Prefix string
+}
+
+type Authenticator interface {
+	Authenticate(r *http.Request) error
+}
+
+func NewStaticTokenAuthenticator(token string) (Authenticator, error) {
+	if token == "" {
+		return nil, errors.New("token must not be empty")
+	}
+	return &staticTokenAuthenticator{token: []byte(token)}, nil
+}
+
+func NewJWTAuthenticator(verifier xjwt.Verifier, requiredScopes []string) (Authenticator, error) {
+	if verifier == nil {
+		return nil, errors.New("verifier must not be nil")
+	}
+	if xjwt.IsUnsigned(verifier) {
+		return nil, errors.New("verifier must check token signatures")
+	}
+	if len(requiredScopes) == 0 {
+		return nil, errors.New("required scopes must not be empty")
+	}
+	return &jwtAuthenticator{verifier: verifier, scopes: slices.Clone(requiredScopes)}, nil
+}
+
+func NewClientCertAuthenticator() Authenticator {
+	return &clientCertAuthenticator{}
+}
+
+// NewLifecycleRecorder wraps logger so that fx lifecycle hook timings can be
+// served by the actuator. It must be installed with fx.WithLogger and supplied
+// to the container, e.g.
+//
+//	rec := actuator.NewLifecycleRecorder(fxevent.NopLogger)
+//	fx.New(fx.WithLogger(func() fxevent.Logger { return rec }), fx.Supply(rec), ...)
+func NewLifecycleRecorder(logger fxevent.Logger) *LifecycleRecorder {
+	return &LifecycleRecorder{logger: logger, created: time.Now()}
 }
 
 type params struct {
 	fx.In
-	Lifecycle      fx.Lifecycle
-	HealthCheckers []HealthChecker `group:"healthcheck"`
-	Config         HealthCheckConfig
+	Lifecycle        fx.Lifecycle
+	HealthCheckers   []HealthChecker `group:"healthcheck"`
+	LivenessCheckers []HealthChecker `group:"liveness"`
+	Config           HealthCheckConfig
+	MeterProvider    *metric.MeterProvider `optional:"true"`
+	Clock            Clock                 `optional:"true"`
+	Recorder         *LifecycleRecorder    `optional:"true"`
 }
 
 func New(p params) (Actuator, error) {
+	timeout := p.Config.HealthCheckTimeout
+	if timeout <= 0 {
+		timeout = p.Config.HealthCheckInterval
+	}
+
+	clock := p.Clock
+	if clock == nil {
+		clock = systemClock{}
+	}
+
 	a := &actuator{
-		healthCheckers: p.HealthCheckers,
-		config:         p.Config,
+		config:      p.Config,
+		clock:       clock,
+		refresh:     make(chan struct{}, 1),
+		wake:        make(chan struct{}, 1),
+		drained:     make(chan struct{}),
+		subscribers: make(map[chan HealthEvent]struct{}),
+	}
+
+	a.components = newComponents(probeReadiness, p.HealthCheckers, CheckerOptions{
+		Interval: p.Config.HealthCheckInterval,
+		Timeout:  timeout,
+	})
+
+	groups, err := newGroups(a.components, p.Config.Groups)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure health groups: %w", err)
+	}
+	a.groups = groups
+
+	a.livenessComponents = newComponents(probeLiveness, append(slices.Clone(p.LivenessCheckers), &watchdog{
+		clock:     clock,
+		heartbeat: &a.heartbeat,
+		maxStall:  3 * p.Config.HealthCheckInterval,
+	}), CheckerOptions{
+		Interval: a.livenessInterval(),
+		Timeout:  min(timeout, a.livenessInterval()),
+	})
+	a.alive.Store(true)
+
+	if p.Recorder != nil {
+		a.tracked.Store(true)
+		p.Recorder.onStarted(a.Boot)
+	}
+
+	if p.MeterProvider != nil {
+		m, err := newMetrics(p.MeterProvider, a)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create health metrics: %w", err)
+		}
+		a.metrics = m
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 	p.Lifecycle.Append(fx.Hook{
 		OnStart: func(_ context.Context) error {
+			a.heartbeat.Store(clock.Now().UnixNano())
+			if !a.tracked.Load() {
+				slog.Warn("actuator startup is untracked, readiness may turn on before startup completes")
+			}
 			go a.monitor(ctx)
+			go a.monitorLiveness(ctx)
 			return nil
 		},
 		OnStop: func(_ context.Context) error {
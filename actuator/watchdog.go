@@ -0,0 +1,26 @@
+package actuator
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+type watchdog struct {
+	clock     Clock
+	heartbeat *atomic.Int64
+	maxStall  time.Duration
+}
+
+func (w *watchdog) Name() string {
+	return "actuator_monitor"
+}
+
+func (w *watchdog) Check(_ context.Context) error {
+	stall := w.clock.Now().Sub(time.Unix(0, w.heartbeat.Load()))
+	if stall > w.maxStall {
+		return fmt.Errorf("no health check round completed for %s", stall.Round(time.Millisecond))
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package dto
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type CursorPageable struct {
+	Size  int    `json:"size"`
+	Sort  []Sort `json:"sort"`
+	After string `json:"after,omitempty"`
+}
+
+func NewCursorPageable(size int) CursorPageable {
+	if size <= 0 {
+		size = 20
+	}
+
+	return CursorPageable{
+		Size: size,
+		Sort: []Sort{},
+	}
+}
+
+func (p CursorPageable) WithSort(prop string, dir Direction) CursorPageable {
+	newSort := append(p.Sort, Sort{Property: prop, Direction: dir})
+	return CursorPageable{
+		Size:  p.Size,
+		Sort:  newSort,
+		After: p.After,
+	}
+}
+
+func (p CursorPageable) WithSorts(sorts ...Sort) CursorPageable {
+	newSort := append(slices.Clone(p.Sort), sorts...)
+	return CursorPageable{
+		Size:  p.Size,
+		Sort:  newSort,
+		After: p.After,
+	}
+}
+
+// String returns the canonical form of p, e.g.
+// "size=20&sort=name,asc&after=...", suitable for cache keys.
+func (p CursorPageable) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "size=%d", p.Size)
+	writeSorts(&b, p.Sort)
+	if p.After != "" {
+		b.WriteString("&after=")
+		b.WriteString(p.After)
+	}
+	return b.String()
+}
+
+func (p CursorPageable) WithCursor(cursor string) CursorPageable {
+	return CursorPageable{
+		Size:  p.Size,
+		Sort:  p.Sort,
+		After: cursor,
+	}
+}
+
+func (p CursorPageable) IsFirst() bool {
+	return p.After == ""
+}
+
+func (p CursorPageable) Limit() int {
+	return p.Size
+}
+
+type cursorPayload struct {
+	Sort string            `json:"s"`
+	Keys []json.RawMessage `json:"k"`
+}
+
+// CursorCodec encodes the sort keys of a row into an opaque cursor. When
+// created with a secret, cursors are HMAC-SHA256 signed and rejected on
+// tampering; a nil codec produces unsigned cursors.
+type CursorCodec struct {
+	secret []byte
+}
+
+func NewCursorCodec(secret []byte) *CursorCodec {
+	return &CursorCodec{secret: bytes.Clone(secret)}
+}
+
+func (c *CursorCodec) Encode(sort []Sort, keys ...any) (string, error) {
+	payload := cursorPayload{Sort: sortKey(sort), Keys: make([]json.RawMessage, len(keys))}
+	for i, k := range keys {
+		raw, err := json.Marshal(k)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode cursor key: %w", err)
+		}
+		payload.Keys[i] = raw
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	cursor := base64.RawURLEncoding.EncodeToString(data)
+	if c.signed() {
+		cursor += "." + base64.RawURLEncoding.EncodeToString(c.sign(data))
+	}
+
+	return cursor, nil
+}
+
+// Decode verifies the cursor of p against its sort order and unmarshals the
+// encoded keys into dest, in sort order.
+func (c *CursorCodec) Decode(p CursorPageable, dest ...any) error {
+	encoded, sig, hasSig := strings.Cut(p.After, ".")
+	if c.signed() != hasSig {
+		return ErrInvalidCursor
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+
+	if hasSig {
+		mac, err := base64.RawURLEncoding.DecodeString(sig)
+		if err != nil || !hmac.Equal(mac, c.sign(data)) {
+			return ErrInvalidCursor
+		}
+	}
+
+	var payload cursorPayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return ErrInvalidCursor
+	}
+
+	if payload.Sort != sortKey(p.Sort) {
+		return fmt.Errorf("%w: sort order does not match", ErrInvalidCursor)
+	}
+
+	if len(payload.Keys) != len(dest) {
+		return fmt.Errorf("%w: expected %d keys, got %d", ErrInvalidCursor, len(dest), len(payload.Keys))
+	}
+
+	for i, raw := range payload.Keys {
+		if err := json.Unmarshal(raw, dest[i]); err != nil {
+			return fmt.Errorf("%w: %v", ErrInvalidCursor, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *CursorCodec) signed() bool {
+	return c != nil && len(c.secret) > 0
+}
+
+func (c *CursorCodec) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func sortKey(sort []Sort) string {
+	parts := make([]string, len(sort))
+	for i, s := range sort {
+		parts[i] = s.String()
+	}
+	return strings.Join(parts, ";")
+}
+
+type CursorSlice[T any] struct {
+	Content          []T
+	Pageable         CursorPageable
+	NumberOfElements int
+	NextCursor       string
+	HasNext          bool
+	IsFirst          bool
+	IsLast           bool
+}
+
+// NewCursorSlice expects content to hold up to Size+1 rows; the extra row only
+// signals that a next page exists. The next cursor is built from the keys of
+// the last returned row.
+func NewCursorSlice[T any](content []T, pageable CursorPageable, codec *CursorCodec, keys func(T) []any) (CursorSlice[T], error) {
+	hasNext := len(content) > pageable.Size
+
+	actualContent := content
+	if actualContent == nil {
+		actualContent = []T{}
+	}
+
+	if hasNext {
+		actualContent = content[:pageable.Size]
+	}
+
+	var next string
+	if hasNext && len(actualContent) > 0 {
+		cursor, err := codec.Encode(pageable.Sort, keys(actualContent[len(actualContent)-1])...)
+		if err != nil {
+			return CursorSlice[T]{}, err
+		}
+		next = cursor
+	}
+
+	return CursorSlice[T]{
+		Content:          actualContent,
+		Pageable:         pageable,
+		NumberOfElements: len(actualContent),
+		NextCursor:       next,
+		HasNext:          hasNext,
+		IsFirst:          pageable.IsFirst(),
+		IsLast:           !hasNext,
+	}, nil
+}
+
+// Cursor slices follow the Slice representation, with the page object holding
+// the cursors instead of a page number:
+//
+//	{"content": [...], "page": {"size": 20, "numberOfElements": 20, "sort": [],
+//	  "first": true, "last": false, "hasNext": true, "cursor": "", "nextCursor": "..."}}
+type cursorMetadata struct {
+	Size             int    `json:"size"`
+	NumberOfElements int    `json:"numberOfElements"`
+	Sort             []Sort `json:"sort"`
+	First            bool   `json:"first"`
+	Last             bool   `json:"last"`
+	HasNext          bool   `json:"hasNext"`
+	Cursor           string `json:"cursor,omitempty"`
+	NextCursor       string `json:"nextCursor,omitempty"`
+}
+
+type cursorSliceJSON[T any] struct {
+	Content []T            `json:"content"`
+	Page    cursorMetadata `json:"page"`
+}
+
+func (s CursorSlice[T]) MarshalJSON() ([]byte, error) {
+	content := s.Content
+	if content == nil {
+		content = []T{}
+	}
+
+	sort := s.Pageable.Sort
+	if sort == nil {
+		sort = []Sort{}
+	}
+
+	return json.Marshal(cursorSliceJSON[T]{
+		Content: content,
+		Page: cursorMetadata{
+			Size:             s.Pageable.Size,
+			NumberOfElements: s.NumberOfElements,
+			Sort:             sort,
+			First:            s.IsFirst,
+			Last:             s.IsLast,
+			HasNext:          s.HasNext,
+			Cursor:           s.Pageable.After,
+			NextCursor:       s.NextCursor,
+		},
+	})
+}
+
+func (s *CursorSlice[T]) UnmarshalJSON(data []byte) error {
+	var v cursorSliceJSON[T]
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*s = CursorSlice[T]{
+		Content:          v.Content,
+		Pageable:         CursorPageable{Size: v.Page.Size, Sort: v.Page.Sort, After: v.Page.Cursor},
+		NumberOfElements: v.Page.NumberOfElements,
+		NextCursor:       v.Page.NextCursor,
+		HasNext:          v.Page.HasNext,
+		IsFirst:          v.Page.First,
+		IsLast:           v.Page.Last,
+	}
+	return nil
+}
+
+func (s CursorSlice[T]) NextPageable() (CursorPageable, bool) {
+	if !s.HasNext {
+		return CursorPageable{}, false
+	}
+	return s.Pageable.WithCursor(s.NextCursor), true
+}
+
+func (s CursorSlice[T]) FirstPageable() CursorPageable {
+	return s.Pageable.WithCursor("")
+}
+
+func MapCursorSlice[T any, U any](s CursorSlice[T], fn func(T) U) CursorSlice[U] {
+	mapped := []U{}
+
+	if len(s.Content) > 0 {
+		mapped = make([]U, len(s.Content))
+		for i, item := range s.Content {
+			mapped[i] = fn(item)
+		}
+	}
+
+	return CursorSlice[U]{
+		Content:          mapped,
+		Pageable:         s.Pageable,
+		NumberOfElements: len(mapped),
+		NextCursor:       s.NextCursor,
+		HasNext:          s.HasNext,
+		IsFirst:          s.IsFirst,
+		IsLast:           s.IsLast,
+	}
+}
@@ -0,0 +1,8 @@
+package dto
+
+import "errors"
+
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidSort   = errors.New("invalid sort")
+)
@@ -1,10 +1,18 @@
 package dto
 
-import "math"
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
 
 type Sort struct {
-	Property  string
-	Direction Direction
+	Property   string       `json:"property"`
+	Direction  Direction    `json:"direction"`
+	Nulls      NullHandling `json:"nulls,omitempty"`
+	IgnoreCase bool         `json:"ignoreCase,omitempty"`
 }
 
 type Direction string
@@ -15,9 +23,9 @@ const (
 )
 
 type Pageable struct {
-	Page int
-	Size int
-	Sort []Sort
+	Page int    `json:"page"`
+	Size int    `json:"size"`
+	Sort []Sort `json:"sort"`
 }
 
 func NewPageable(page, size int) Pageable {
@@ -45,6 +53,25 @@ func (p Pageable) WithSort(prop string, dir Direction) Pageable {
 	}
 }
 
+func (p Pageable) WithSorts(sorts ...Sort) Pageable {
+	newSort := append(slices.Clone(p.Sort), sorts...)
+	return Pageable{
+		Page: p.Page,
+		Size: p.Size,
+		Sort: newSort,
+	}
+}
+
+// String returns the canonical form of p, e.g.
+// "page=0&size=20&sort=name,desc,nullslast&sort=id,asc", suitable for cache
+// keys. Equal pageables always produce the same string.
+func (p Pageable) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "page=%d&size=%d", p.Page, p.Size)
+	writeSorts(&b, p.Sort)
+	return b.String()
+}
+
 func (p Pageable) Offset() int {
 	return p.Page * p.Size
 }
@@ -192,3 +219,138 @@ func MapPage[T any, U any](p Page[T], fn func(T) U) Page[U] {
 		TotalPages:       p.TotalPages,
 	}
 }
+
+// Slices and pages are serialized as their content plus a nested "page"
+// object:
+//
+//	{
+//	  "content": [...],
+//	  "page": {
+//	    "number": 1,
+//	    "size": 20,
+//	    "numberOfElements": 20,
+//	    "sort": [{"property": "name", "direction": "ASC"}],
+//	    "first": false,
+//	    "last": false,
+//	    "hasNext": true,
+//	    "hasPrevious": true,
+//	    "totalElements": 95,
+//	    "totalPages": 5
+//	  }
+//	}
+//
+// totalElements and totalPages are only present for a Page.
+type sliceMetadata struct {
+	Number           int    `json:"number"`
+	Size             int    `json:"size"`
+	NumberOfElements int    `json:"numberOfElements"`
+	Sort             []Sort `json:"sort"`
+	First            bool   `json:"first"`
+	Last             bool   `json:"last"`
+	HasNext          bool   `json:"hasNext"`
+	HasPrevious      bool   `json:"hasPrevious"`
+}
+
+type pageMetadata struct {
+	sliceMetadata
+	TotalElements int `json:"totalElements"`
+	TotalPages    int `json:"totalPages"`
+}
+
+type sliceJSON[T any] struct {
+	Content []T           `json:"content"`
+	Page    sliceMetadata `json:"page"`
+}
+
+type pageJSON[T any] struct {
+	Content []T          `json:"content"`
+	Page    pageMetadata `json:"page"`
+}
+
+func newSliceMetadata(pageable Pageable, numberOfElements int, isFirst, isLast, hasNext, hasPrev bool) sliceMetadata {
+	sort := pageable.Sort
+	if sort == nil {
+		sort = []Sort{}
+	}
+
+	return sliceMetadata{
+		Number:           pageable.Page,
+		Size:             pageable.Size,
+		NumberOfElements: numberOfElements,
+		Sort:             sort,
+		First:            isFirst,
+		Last:             isLast,
+		HasNext:          hasNext,
+		HasPrevious:      hasPrev,
+	}
+}
+
+func (m sliceMetadata) pageable() Pageable {
+	return Pageable{Page: m.Number, Size: m.Size, Sort: m.Sort}
+}
+
+func (s Slice[T]) MarshalJSON() ([]byte, error) {
+	content := s.Content
+	if content == nil {
+		content = []T{}
+	}
+
+	return json.Marshal(sliceJSON[T]{
+		Content: content,
+		Page:    newSliceMetadata(s.Pageable, s.NumberOfElements, s.IsFirst, s.IsLast, s.HasNext, s.HasPrev),
+	})
+}
+
+func (s *Slice[T]) UnmarshalJSON(data []byte) error {
+	var v sliceJSON[T]
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*s = Slice[T]{
+		Content:          v.Content,
+		Pageable:         v.Page.pageable(),
+		NumberOfElements: v.Page.NumberOfElements,
+		HasNext:          v.Page.HasNext,
+		HasPrev:          v.Page.HasPrevious,
+		IsFirst:          v.Page.First,
+		IsLast:           v.Page.Last,
+	}
+	return nil
+}
+
+func (p Page[T]) MarshalJSON() ([]byte, error) {
+	content := p.Content
+	if content == nil {
+		content = []T{}
+	}
+
+	return json.Marshal(pageJSON[T]{
+		Content: content,
+		Page: pageMetadata{
+			sliceMetadata: newSliceMetadata(p.Pageable, p.NumberOfElements, p.IsFirst, p.IsLast, p.HasNext, p.HasPrev),
+			TotalElements: p.TotalElements,
+			TotalPages:    p.TotalPages,
+		},
+	})
+}
+
+func (p *Page[T]) UnmarshalJSON(data []byte) error {
+	var v pageJSON[T]
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*p = Page[T]{
+		Content:          v.Content,
+		Pageable:         v.Page.pageable(),
+		NumberOfElements: v.Page.NumberOfElements,
+		HasNext:          v.Page.HasNext,
+		HasPrev:          v.Page.HasPrevious,
+		IsFirst:          v.Page.First,
+		IsLast:           v.Page.Last,
+		TotalElements:    v.Page.TotalElements,
+		TotalPages:       v.Page.TotalPages,
+	}
+	return nil
+}
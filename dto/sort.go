@@ -0,0 +1,156 @@
+package dto
+
+import (
+	"fmt"
+	"strings"
+)
+
+type NullHandling string
+
+const (
+	NullsNative NullHandling = ""
+	NullsFirst  NullHandling = "NULLS_FIRST"
+	NullsLast   NullHandling = "NULLS_LAST"
+)
+
+const (
+	tokenAsc        = "asc"
+	tokenDesc       = "desc"
+	tokenNullsFirst = "nullsfirst"
+	tokenNullsLast  = "nullslast"
+	tokenIgnoreCase = "ignorecase"
+)
+
+func (s Sort) WithNulls(n NullHandling) Sort {
+	s.Nulls = n
+	return s
+}
+
+func (s Sort) WithIgnoreCase() Sort {
+	s.IgnoreCase = true
+	return s
+}
+
+// String formats s as "property,direction[,nullsfirst|nullslast][,ignorecase]",
+// which ParseSort accepts.
+func (s Sort) String() string {
+	var b strings.Builder
+	b.WriteString(s.Property)
+	b.WriteByte(',')
+
+	if s.Direction == DESC {
+		b.WriteString(tokenDesc)
+	} else {
+		b.WriteString(tokenAsc)
+	}
+
+	switch s.Nulls {
+	case NullsFirst:
+		b.WriteString("," + tokenNullsFirst)
+	case NullsLast:
+		b.WriteString("," + tokenNullsLast)
+	}
+
+	if s.IgnoreCase {
+		b.WriteString("," + tokenIgnoreCase)
+	}
+
+	return b.String()
+}
+
+// ParseSort parses a single sort such as "name", "-name" or
+// "name,desc,nullslast,ignorecase". Modifiers are case-insensitive.
+func ParseSort(v string) (Sort, error) {
+	sorts, err := ParseSorts(v)
+	if err != nil {
+		return Sort{}, err
+	}
+
+	if len(sorts) != 1 {
+		return Sort{}, fmt.Errorf("%w: %s names more than one property", ErrInvalidSort, v)
+	}
+
+	return sorts[0], nil
+}
+
+// ParseSorts additionally accepts the Spring form "prop1,prop2,desc", where the
+// trailing modifiers apply to every listed property.
+func ParseSorts(v string) ([]Sort, error) {
+	parts := strings.Split(v, ",")
+
+	i := len(parts)
+	for i > 1 && isSortModifier(parts[i-1]) {
+		i--
+	}
+	props, modifiers := parts[:i], parts[i:]
+
+	var mod Sort
+	var hasDir, hasNulls bool
+	for _, m := range modifiers {
+		switch strings.ToLower(strings.TrimSpace(m)) {
+		case tokenAsc, tokenDesc:
+			if hasDir {
+				return nil, fmt.Errorf("%w: %s has more than one direction", ErrInvalidSort, v)
+			}
+			hasDir = true
+			mod.Direction = Direction(strings.ToUpper(strings.TrimSpace(m)))
+		case tokenNullsFirst, tokenNullsLast:
+			if hasNulls {
+				return nil, fmt.Errorf("%w: %s has more than one null handling", ErrInvalidSort, v)
+			}
+			hasNulls = true
+			mod.Nulls = NullsFirst
+			if strings.EqualFold(strings.TrimSpace(m), tokenNullsLast) {
+				mod.Nulls = NullsLast
+			}
+		case tokenIgnoreCase:
+			mod.IgnoreCase = true
+		}
+	}
+
+	sorts := make([]Sort, 0, len(props))
+	for _, p := range props {
+		p = strings.TrimSpace(p)
+
+		s := mod
+		s.Direction = ASC
+		if hasDir {
+			s.Direction = mod.Direction
+		}
+
+		if prop, ok := strings.CutPrefix(p, "-"); ok {
+			if hasDir {
+				return nil, fmt.Errorf("%w: %s has more than one direction", ErrInvalidSort, v)
+			}
+			s.Direction = DESC
+			p = prop
+		} else {
+			p = strings.TrimPrefix(p, "+")
+		}
+
+		if p == "" {
+			return nil, fmt.Errorf("%w: %s is missing a sort property", ErrInvalidSort, v)
+		}
+
+		s.Property = p
+		sorts = append(sorts, s)
+	}
+
+	return sorts, nil
+}
+
+func isSortModifier(token string) bool {
+	switch strings.ToLower(strings.TrimSpace(token)) {
+	case tokenAsc, tokenDesc, tokenNullsFirst, tokenNullsLast, tokenIgnoreCase:
+		return true
+	default:
+		return false
+	}
+}
+
+func writeSorts(b *strings.Builder, sorts []Sort) {
+	for _, s := range sorts {
+		b.WriteString("&sort=")
+		b.WriteString(s.String())
+	}
+}
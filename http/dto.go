@@ -0,0 +1,8 @@
+package http
+
+type RouteMapping struct {
+	Method      string   `json:"method"`
+	Path        string   `json:"path"`
+	Name        string   `json:"name"`
+	Middlewares []string `json:"middlewares"`
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/bencoronard/demo-go-common-libs/dto"
+	"github.com/labstack/echo/v5"
+)
+
+type pageLink struct {
+	rel      string
+	pageable dto.Pageable
+}
+
+// JSONPage renders p and emits RFC 8288 Link headers for the first, previous,
+// next and last pages, built from the current request URL.
+func JSONPage[T any](c *echo.Context, code int, p dto.Page[T]) error {
+	links := []pageLink{{rel: "first", pageable: dto.NewPageable(0, p.Pageable.Size)}}
+	if prev, ok := p.PreviousPageable(); ok {
+		links = append(links, pageLink{rel: "prev", pageable: prev})
+	}
+	if next, ok := p.NextPageable(); ok {
+		links = append(links, pageLink{rel: "next", pageable: next})
+	}
+	if p.TotalPages > 0 {
+		links = append(links, pageLink{rel: "last", pageable: dto.NewPageable(p.TotalPages-1, p.Pageable.Size)})
+	}
+
+	setLinks(c, links)
+	return c.JSON(code, p)
+}
+
+// JSONSlice is JSONPage for slices, which have no last page link since the
+// total is unknown.
+func JSONSlice[T any](c *echo.Context, code int, s dto.Slice[T]) error {
+	links := []pageLink{{rel: "first", pageable: dto.NewPageable(0, s.Pageable.Size)}}
+	if prev, ok := s.PreviousPageable(); ok {
+		links = append(links, pageLink{rel: "prev", pageable: prev})
+	}
+	if next, ok := s.NextPageable(); ok {
+		links = append(links, pageLink{rel: "next", pageable: next})
+	}
+
+	setLinks(c, links)
+	return c.JSON(code, s)
+}
+
+func setLinks(c *echo.Context, links []pageLink) {
+	r := c.Request()
+
+	values := make([]string, len(links))
+	for i, l := range links {
+		q := r.URL.Query()
+		q.Set("page", strconv.Itoa(l.pageable.Page))
+		q.Set("size", strconv.Itoa(l.pageable.Size))
+
+		u := url.URL{Path: r.URL.Path, RawPath: r.URL.RawPath, RawQuery: q.Encode()}
+		values[i] = fmt.Sprintf(`<%s>; rel="%s"`, u.String(), l.rel)
+	}
+
+	c.Response().Header().Set("Link", strings.Join(values, ", "))
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"reflect"
+	"runtime"
+	"sync"
+
+	"github.com/labstack/echo/v5"
+)
+
+type mappingRouter struct {
+	echo.Router
+	mu          sync.RWMutex
+	middlewares map[string][]string
+}
+
+func newMappingRouter() *mappingRouter {
+	return &mappingRouter{
+		Router:      echo.NewRouter(echo.RouterConfig{}),
+		middlewares: map[string][]string{},
+	}
+}
+
+func (r *mappingRouter) Add(route echo.Route) (echo.RouteInfo, error) {
+	ri, err := r.Router.Add(route)
+	if err != nil {
+		return ri, err
+	}
+
+	r.mu.Lock()
+	r.middlewares[ri.Method+" "+ri.Path] = funcNames(route.Middlewares)
+	r.mu.Unlock()
+
+	return ri, nil
+}
+
+func (r *mappingRouter) routeMiddlewares(method, path string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.middlewares[method+" "+path]
+}
+
+func funcNames(middlewares []echo.MiddlewareFunc) []string {
+	names := make([]string, 0, len(middlewares))
+	for _, m := range middlewares {
+		fn := runtime.FuncForPC(reflect.ValueOf(m).Pointer())
+		if fn == nil {
+			names = append(names, "unknown")
+			continue
+		}
+		names = append(names, fn.Name())
+	}
+	return names
+}
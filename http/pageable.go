@@ -0,0 +1,105 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/bencoronard/demo-go-common-libs/dto"
+	"github.com/bencoronard/demo-go-common-libs/validator"
+	"github.com/labstack/echo/v5"
+)
+
+const (
+	defaultPageSize    = 20
+	defaultMaxPageSize = 100
+)
+
+type pageableBinder struct {
+	defaultSize int
+	maxSize     int
+	sortable    []string
+}
+
+func (b *pageableBinder) Bind(c *echo.Context) (dto.Pageable, error) {
+	query := c.Request().URL.Query()
+
+	var errs []validator.FieldValidationError
+
+	page := 0
+	if v := query.Get("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			errs = append(errs, validator.FieldValidationError{
+				Field:   "page",
+				Message: fmt.Sprintf("%s is not a valid page number", v),
+			})
+		}
+		page = n
+	}
+
+	size := b.defaultSize
+	if v := query.Get("size"); v != "" {
+		n, err := strconv.Atoi(v)
+		switch {
+		case err != nil || n <= 0:
+			errs = append(errs, validator.FieldValidationError{
+				Field:   "size",
+				Message: fmt.Sprintf("%s is not a valid page size", v),
+			})
+		case n > b.maxSize:
+			errs = append(errs, validator.FieldValidationError{
+				Field:   "size",
+				Message: fmt.Sprintf("page size must not exceed %d", b.maxSize),
+			})
+		}
+		size = n
+	}
+
+	// Reject pages whose offset (page*size) would overflow.
+	if page > 0 && size > 0 && page > math.MaxInt/size {
+		errs = append(errs, validator.FieldValidationError{
+			Field:   "page",
+			Message: fmt.Sprintf("page number must not exceed %d", math.MaxInt/size),
+		})
+	}
+
+	pageable := dto.NewPageable(page, size)
+
+	for _, v := range query["sort"] {
+		sorts, err := b.parseSort(v)
+		if err != nil {
+			errs = append(errs, validator.FieldValidationError{
+				Field:   "sort",
+				Message: err.Error(),
+			})
+			continue
+		}
+		pageable = pageable.WithSorts(sorts...)
+	}
+
+	if len(errs) > 0 {
+		return dto.Pageable{}, &validator.ValidationError{Errors: errs}
+	}
+
+	return pageable, nil
+}
+
+// parseSort accepts the forms understood by dto.ParseSorts, such as "prop",
+// "-prop", "prop,desc,nullslast" or "prop1,prop2,desc", and rejects properties
+// outside the whitelist.
+func (b *pageableBinder) parseSort(v string) ([]dto.Sort, error) {
+	sorts, err := dto.ParseSorts(v)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range sorts {
+		if !slices.Contains(b.sortable, s.Property) {
+			return nil, fmt.Errorf("%s is not a sortable property", s.Property)
+		}
+	}
+
+	return sorts, nil
+}
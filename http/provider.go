@@ -2,6 +2,7 @@ package http
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/bencoronard/demo-go-common-libs/dto"
 	xjwt "github.com/bencoronard/demo-go-common-libs/jwt"
@@ -19,6 +20,34 @@ func NewHttpAuthHeaderResolver(verifier xjwt.Verifier) AuthHeaderResolver {
 	return &authHeaderResolver{verifier: verifier}
 }
 
+type PageableConfig struct {
+	DefaultSize int
+	MaxSize     int
+	// SortableProperties whitelists the properties accepted in sort
+	// parameters; sorting is rejected when empty.
+	SortableProperties []string
+}
+
+type PageableBinder interface {
+	Bind(c *echo.Context) (dto.Pageable, error)
+}
+
+func NewPageableBinder(cfg PageableConfig) PageableBinder {
+	if cfg.DefaultSize <= 0 {
+		cfg.DefaultSize = defaultPageSize
+	}
+
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = max(defaultMaxPageSize, cfg.DefaultSize)
+	}
+
+	return &pageableBinder{
+		defaultSize: min(cfg.DefaultSize, cfg.MaxSize),
+		maxSize:     cfg.MaxSize,
+		sortable:    slices.Clone(cfg.SortableProperties),
+	}
+}
+
 type AppErrorHandler interface {
 	Handle(err error, pd dto.ProblemDetail) (dto.ProblemDetail, bool)
 }
@@ -39,3 +68,25 @@ func NewGlobalErrorHandler(p globalErrorHandlerParams) GlobalErrorHandler {
 		tracerProvider: p.TracerProvider,
 	}
 }
+
+func Mappings(e *echo.Echo) []RouteMapping {
+	global := append(funcNames(e.PreMiddlewares()), funcNames(e.Middlewares())...)
+	mr, _ := e.Router().(*mappingRouter)
+
+	routes := e.Router().Routes()
+	mappings := make([]RouteMapping, 0, len(routes))
+	for _, ri := range routes {
+		middlewares := slices.Clone(global)
+		if mr != nil {
+			middlewares = append(middlewares, mr.routeMiddlewares(ri.Method, ri.Path)...)
+		}
+		mappings = append(mappings, RouteMapping{
+			Method:      ri.Method,
+			Path:        ri.Path,
+			Name:        ri.Name,
+			Middlewares: middlewares,
+		})
+	}
+
+	return mappings
+}
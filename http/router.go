@@ -29,7 +29,7 @@ type routerParams struct {
 }
 
 func NewRouter(p routerParams) *echo.Echo {
-	e := echo.New()
+	e := echo.NewWithConfig(echo.Config{Router: newMappingRouter()})
 
 	e.HTTPErrorHandler = p.ErrHandler.GetHandler()
 
@@ -70,6 +70,11 @@ func NewUnsignedVerifier(cfg UnsignedVerifierConfig) Verifier {
 	return &unsignedVerifier{parser: jwt.NewParser(opts...)}
 }
 
+func IsUnsigned(v Verifier) bool {
+	_, ok := v.(*unsignedVerifier)
+	return ok
+}
+
 type SymmVerifierConfig struct {
 	UnsignedVerifierConfig
 	Key []byte
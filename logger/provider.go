@@ -9,10 +9,21 @@ import (
 	"go.uber.org/fx"
 )
 
-func NewStdOutLogger() *slog.Logger {
+func NewLevelVar() *slog.LevelVar {
+	lv := new(slog.LevelVar)
+	lv.Set(slog.LevelInfo)
+	return lv
+}
+
+type stdOutLoggerParams struct {
+	fx.In
+	Level *slog.LevelVar `optional:"true"`
+}
+
+func NewStdOutLogger(p stdOutLoggerParams) *slog.Logger {
 	opts := &slog.HandlerOptions{
 		AddSource: true,
-		Level:     slog.LevelInfo,
+		Level:     leveler(p.Level),
 	}
 
 	handler := slog.NewTextHandler(os.Stdout, opts)
@@ -26,7 +37,8 @@ func NewStdOutLogger() *slog.Logger {
 
 type otelLoggerParams struct {
 	fx.In
-	Lp *log.LoggerProvider
+	Lp    *log.LoggerProvider
+	Level *slog.LevelVar `optional:"true"`
 }
 
 func NewOtelLogger(p otelLoggerParams) *slog.Logger {
@@ -35,7 +47,10 @@ func NewOtelLogger(p otelLoggerParams) *slog.Logger {
 		otelslog.WithSource(true),
 	}
 
-	handler := otelslog.NewHandler("", opts...)
+	handler := &levelHandler{
+		Handler: otelslog.NewHandler("", opts...),
+		level:   leveler(p.Level),
+	}
 
 	logger := slog.New(handler)
 
@@ -43,3 +58,10 @@ func NewOtelLogger(p otelLoggerParams) *slog.Logger {
 
 	return logger
 }
+
+func leveler(lv *slog.LevelVar) slog.Leveler {
+	if lv == nil {
+		return slog.LevelInfo
+	}
+	return lv
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	prom "github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/prometheus"
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/log"
@@ -63,18 +65,27 @@ func NewTracerProvider(p params) (*trace.TracerProvider, error) {
 	return provider, nil
 }
 
-func NewMeterProvider(p params) (*metric.MeterProvider, error) {
+type meterProviderParams struct {
+	params
+	Readers []metric.Reader `group:"metric_reader"`
+}
+
+func NewMeterProvider(p meterProviderParams) (*metric.MeterProvider, error) {
 	exporter, err := otlpmetricgrpc.New(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create exporter: %w", err)
 	}
 
-	reader := metric.NewPeriodicReader(exporter)
-
-	provider := metric.NewMeterProvider(
+	opts := []metric.Option{
 		metric.WithResource(p.Resource),
-		metric.WithReader(reader),
-	)
+		metric.WithReader(metric.NewPeriodicReader(exporter)),
+	}
+
+	for _, r := range p.Readers {
+		opts = append(opts, metric.WithReader(r))
+	}
+
+	provider := metric.NewMeterProvider(opts...)
 
 	otel.SetMeterProvider(provider)
 
@@ -90,6 +101,23 @@ func NewMeterProvider(p params) (*metric.MeterProvider, error) {
 	return provider, nil
 }
 
+func NewPrometheusRegistry() *prom.Registry {
+	return prom.NewRegistry()
+}
+
+type prometheusReaderParams struct {
+	fx.In
+	Registry *prom.Registry
+}
+
+func NewPrometheusReader(p prometheusReaderParams) (metric.Reader, error) {
+	exporter, err := prometheus.New(prometheus.WithRegisterer(p.Registry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exporter: %w", err)
+	}
+	return exporter, nil
+}
+
 func NewLoggerProvider(p params) (*log.LoggerProvider, error) {
 	exporter, err := otlploggrpc.New(context.Background())
 	if err != nil {
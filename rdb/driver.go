@@ -3,6 +3,7 @@ package rdb
 import (
 	"fmt"
 
+	"github.com/bencoronard/demo-go-common-libs/actuator"
 	"go.uber.org/fx"
 
 	"gorm.io/driver/postgres"
@@ -18,6 +19,10 @@ type DriverConfig struct {
 	UseSSL   bool
 }
 
+func NewDriverConfigEntry(cfg DriverConfig) actuator.ConfigEntry {
+	return actuator.NewConfigEntry("rdb_driver", cfg)
+}
+
 type driverParams struct {
 	fx.In
 	Config DriverConfig
@@ -0,0 +1,5 @@
+package rdb
+
+import "errors"
+
+var ErrSortPropertyNotAllowed = errors.New("sort property not allowed")
@@ -0,0 +1,104 @@
+package rdb
+
+import (
+	"fmt"
+
+	"github.com/bencoronard/demo-go-common-libs/dto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func FindPage[T any](db *gorm.DB, pageable dto.Pageable, columns SortColumns) (dto.Page[T], error) {
+	order, err := columns.orderBy(db, pageable.Sort)
+	if err != nil {
+		return dto.Page[T]{}, err
+	}
+
+	var total int64
+	if err := model[T](db.Session(&gorm.Session{})).Count(&total).Error; err != nil {
+		return dto.Page[T]{}, fmt.Errorf("failed to count rows: %w", err)
+	}
+
+	var content []T
+	if int64(pageable.Offset()) < total {
+		err := model[T](db.Session(&gorm.Session{})).
+			Clauses(order...).
+			Offset(pageable.Offset()).
+			Limit(pageable.Limit()).
+			Find(&content).Error
+		if err != nil {
+			return dto.Page[T]{}, fmt.Errorf("failed to fetch page: %w", err)
+		}
+	}
+
+	return dto.NewPage(content, pageable, int(total)), nil
+}
+
+func FindSlice[T any](db *gorm.DB, pageable dto.Pageable, columns SortColumns) (dto.Slice[T], error) {
+	order, err := columns.orderBy(db, pageable.Sort)
+	if err != nil {
+		return dto.Slice[T]{}, err
+	}
+
+	var content []T
+	err = model[T](db.Session(&gorm.Session{})).
+		Clauses(order...).
+		Offset(pageable.Offset()).
+		Limit(pageable.Limit() + 1).
+		Find(&content).Error
+	if err != nil {
+		return dto.Slice[T]{}, fmt.Errorf("failed to fetch slice: %w", err)
+	}
+
+	return dto.NewSlice(content, pageable, len(content)), nil
+}
+
+// model falls back to T as the query model so that callers may pass a bare
+// *gorm.DB, while keeping any Model or Table they already set.
+func model[T any](db *gorm.DB) *gorm.DB {
+	if db.Statement.Model == nil && db.Statement.Table == "" {
+		return db.Model(new(T))
+	}
+	return db
+}
+
+func (c SortColumns) orderBy(db *gorm.DB, sort []dto.Sort) ([]clause.Expression, error) {
+	if len(sort) == 0 {
+		return nil, nil
+	}
+
+	columns := make([]clause.OrderByColumn, 0, len(sort))
+	for _, s := range sort {
+		column, ok := c[s.Property]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSortPropertyNotAllowed, s.Property)
+		}
+
+		if !s.IgnoreCase && s.Nulls == dto.NullsNative {
+			columns = append(columns, clause.OrderByColumn{
+				Column: clause.Column{Name: column},
+				Desc:   s.Direction == dto.DESC,
+			})
+			continue
+		}
+
+		expr := db.Statement.Quote(column)
+		if s.IgnoreCase {
+			expr = "LOWER(" + expr + ")"
+		}
+		if s.Direction == dto.DESC {
+			expr += " DESC"
+		}
+		switch s.Nulls {
+		case dto.NullsFirst:
+			expr += " NULLS FIRST"
+		case dto.NullsLast:
+			expr += " NULLS LAST"
+		}
+		columns = append(columns, clause.OrderByColumn{
+			Column: clause.Column{Name: expr, Raw: true},
+		})
+	}
+
+	return []clause.Expression{clause.OrderBy{Columns: columns}}, nil
+}
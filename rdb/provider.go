@@ -61,6 +61,10 @@ func NewDB(p dbParams) (*gorm.DB, error) {
 	return db, nil
 }
 
+func NewConfigEntry(cfg DBConfig) actuator.ConfigEntry {
+	return actuator.NewConfigEntry("rdb", cfg)
+}
+
 type healthCheckerParams struct {
 	fx.In
 	DB *gorm.DB
@@ -79,3 +83,7 @@ func NewHealthChecker(p healthCheckerParams) (actuator.HealthChecker, error) {
 		db:   sqlDB,
 	}, nil
 }
+
+// SortColumns whitelists the sort properties accepted by FindPage and
+// FindSlice, mapping each to the column used in ORDER BY.
+type SortColumns map[string]string
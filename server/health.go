@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/bencoronard/demo-go-common-libs/actuator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const healthWatchInterval = time.Second
+
+type healthServer struct {
+	healthpb.UnimplementedHealthServer
+	actuator actuator.Actuator
+}
+
+func (h *healthServer) Check(_ context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	s, ok := h.status(req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown service: %s", req.GetService())
+	}
+	return &healthpb.HealthCheckResponse{Status: s}, nil
+}
+
+func (h *healthServer) List(_ context.Context, _ *healthpb.HealthListRequest) (*healthpb.HealthListResponse, error) {
+	statuses := map[string]*healthpb.HealthCheckResponse{
+		"": {Status: h.overall()},
+	}
+	for _, c := range h.actuator.Health().Components {
+		statuses[c.Name] = &healthpb.HealthCheckResponse{Status: componentStatus(c.Status)}
+	}
+	return &healthpb.HealthListResponse{Statuses: statuses}, nil
+}
+
+func (h *healthServer) Watch(req *healthpb.HealthCheckRequest, stream grpc.ServerStreamingServer[healthpb.HealthCheckResponse]) error {
+	events, unsubscribe := h.actuator.Subscribe()
+	defer unsubscribe()
+
+	ticker := time.NewTicker(healthWatchInterval)
+	defer ticker.Stop()
+
+	last := healthpb.HealthCheckResponse_ServingStatus(-1)
+
+	for {
+		s, ok := h.status(req.GetService())
+		if !ok {
+			s = healthpb.HealthCheckResponse_SERVICE_UNKNOWN
+		}
+
+		if s != last {
+			if err := stream.Send(&healthpb.HealthCheckResponse{Status: s}); err != nil {
+				return status.Errorf(codes.Canceled, "failed to send health status: %v", err)
+			}
+			last = s
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream has ended")
+		case <-events:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (h *healthServer) status(service string) (healthpb.HealthCheckResponse_ServingStatus, bool) {
+	if service == "" {
+		return h.overall(), true
+	}
+
+	for _, c := range h.actuator.Health().Components {
+		if c.Name == service {
+			return componentStatus(c.Status), true
+		}
+	}
+
+	return healthpb.HealthCheckResponse_SERVICE_UNKNOWN, false
+}
+
+func (h *healthServer) overall() healthpb.HealthCheckResponse_ServingStatus {
+	if h.actuator.Readiness() {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+func componentStatus(s actuator.Status) healthpb.HealthCheckResponse_ServingStatus {
+	switch s {
+	case actuator.StatusUp:
+		return healthpb.HealthCheckResponse_SERVING
+	case actuator.StatusDown:
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	default:
+		return healthpb.HealthCheckResponse_UNKNOWN
+	}
+}
@@ -9,14 +9,17 @@ import (
 	"os"
 	"time"
 
+	"github.com/bencoronard/demo-go-common-libs/actuator"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type ServerParams struct {
 	fx.In
 	Lifecycle  fx.Lifecycle
 	Shutdowner fx.Shutdowner
+	Actuator   actuator.Actuator `optional:"true"`
 }
 
 type HTTPServer interface {
@@ -34,6 +37,10 @@ type HTTPServerConfig struct {
 	MaxHeaderBytes    int
 }
 
+func NewHTTPServerConfigEntry(cfg HTTPServerConfig) actuator.ConfigEntry {
+	return actuator.NewConfigEntry("http_server", cfg)
+}
+
 type httpServerParams struct {
 	ServerParams
 	Server HTTPServer
@@ -56,6 +63,7 @@ func ServeHTTP(p httpServerParams) error {
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			p.drain(ctx)
 			if err := p.Server.Instance().Shutdown(ctx); err != nil {
 				return fmt.Errorf("failed to shudown server: %w", err)
 			}
@@ -77,6 +85,15 @@ type grpcServerParams struct {
 	Server GRPCServer
 }
 
+type healthServerParams struct {
+	fx.In
+	Actuator actuator.Actuator
+}
+
+func NewGRPCHealthServer(p healthServerParams) healthpb.HealthServer {
+	return &healthServer{actuator: p.Actuator}
+}
+
 func ServeGRPC(p grpcServerParams) error {
 	if err := p.Server.Configure(); err != nil {
 		return fmt.Errorf("failed to configure server: %w", err)
@@ -94,6 +111,7 @@ func ServeGRPC(p grpcServerParams) error {
 			return nil
 		},
 		OnStop: func(ctx context.Context) error {
+			p.drain(ctx)
 			stopped := make(chan struct{})
 			go func() {
 				p.Server.Instance().GracefulStop()
@@ -111,3 +129,12 @@ func ServeGRPC(p grpcServerParams) error {
 
 	return nil
 }
+
+func (p ServerParams) drain(ctx context.Context) {
+	if p.Actuator == nil {
+		return
+	}
+	if err := p.Actuator.Drain(ctx); err != nil {
+		slog.Error("server drain interrupted", "error", err)
+	}
+}
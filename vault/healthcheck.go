@@ -0,0 +1,39 @@
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+type healthChecker struct {
+	name   string
+	client *vault.Client
+}
+
+func (h *healthChecker) Name() string {
+	return h.name
+}
+
+func (h *healthChecker) Check(ctx context.Context) error {
+	health, err := h.client.Sys().HealthWithContext(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query health: %w", err)
+	}
+
+	if !health.Initialized {
+		return errors.New("vault is not initialized")
+	}
+
+	if health.Sealed {
+		return errors.New("vault is sealed")
+	}
+
+	if _, err := h.client.Auth().Token().LookupSelfWithContext(ctx); err != nil {
+		return fmt.Errorf("failed to validate token: %w", err)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/bencoronard/demo-go-common-libs/actuator"
 	"go.uber.org/fx"
 
 	vault "github.com/hashicorp/vault/api"
@@ -52,3 +53,24 @@ func New(p params) (Client, error) {
 
 	return &c, nil
 }
+
+func NewConfigEntry(cfg Config) actuator.ConfigEntry {
+	return actuator.NewConfigEntry("vault", cfg)
+}
+
+type healthCheckerParams struct {
+	fx.In
+	Client Client
+}
+
+func NewHealthChecker(p healthCheckerParams) (actuator.HealthChecker, error) {
+	c, ok := p.Client.(*client)
+	if !ok {
+		return nil, fmt.Errorf("unsupported client implementation: %T", p.Client)
+	}
+
+	return &healthChecker{
+		name:   "vault",
+		client: c.client,
+	}, nil
+}